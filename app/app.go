@@ -55,7 +55,7 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distribution.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler, distribution.ProposalHandler),
+		gov.NewAppModuleBasic(paramsClient.ProposalHandler, distribution.ProposalHandler, contracts.ProposalHandler),
 		cParams.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -80,6 +80,7 @@ var (
 		gov.ModuleName:                   {supply.Burner},
 		bonds.BondsMintBurnAccount:       {supply.Minter, supply.Burner},
 		bonds.BatchesIntermediaryAccount: nil,
+		node.ModuleName:                  nil,
 	}
 )
 
@@ -119,8 +120,7 @@ type ixoApp struct {
 	bonddocKeeper  bonddoc.Keeper
 	bondsKeeper    bonds.Keeper
 
-	mm        *module.Manager
-	ethClient ixo.EthClient
+	mm *module.Manager
 }
 
 func NewIxoApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
@@ -155,6 +155,7 @@ func NewIxoApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	slashingSubspace := app.cParamsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.cParamsKeeper.Subspace(gov.DefaultParamspace)
 	crisisSubspace := app.cParamsKeeper.Subspace(crisis.DefaultParamspace)
+	feesSubspace := app.cParamsKeeper.Subspace(fees.DefaultParamspace)
 
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
 	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper, bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
@@ -167,11 +168,17 @@ func NewIxoApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, keys[slashing.StoreKey], &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
+	app.feesKeeper = fees.NewKeeper(app.cdc, feesSubspace)
+
+	app.paramsKeepr = params.NewKeeper(app.cdc, keys[params.StoreKey])
+	app.contractKeeper = contracts.NewKeeper(app.cdc, app.paramsKeepr)
 
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(cParams.RouterKey, cParams.NewParamChangeProposalHandler(app.cParamsKeeper)).
-		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.distributionKeeper))
+		AddRoute(cParams.RouterKey, fees.NewParamChangeProposalHandler(app.feesKeeper,
+			cParams.NewParamChangeProposalHandler(app.cParamsKeeper))).
+		AddRoute(distribution.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(app.distributionKeeper)).
+		AddRoute(contracts.RouterKey, contracts.NewProposalHandler(app.contractKeeper))
 	app.govKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], app.cParamsKeeper, govSubspace,
 		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
 
@@ -179,21 +186,12 @@ func NewIxoApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		app.slashingKeeper.Hooks()))
 
 	app.didKeeper = did.NewKeeper(app.cdc, keys[did.StoreKey])
-	app.paramsKeepr = params.NewKeeper(app.cdc, keys[params.StoreKey])
-	app.feesKeeper = fees.NewKeeper(app.cdc, app.paramsKeepr)
-	app.projectKeeper = project.NewKeeper(app.cdc, keys[project.StoreKey], app.accountKeeper, app.feesKeeper)
-	app.nodeKeeper = node.NewKeeper(app.cdc, app.paramsKeepr)
-	app.contractKeeper = contracts.NewKeeper(app.cdc, app.paramsKeepr)
+	app.projectKeeper = project.NewKeeper(app.cdc, keys[project.StoreKey], app.accountKeeper, app.feesKeeper,
+		app.stakingKeeper, app.supplyKeeper, app.distributionKeeper)
+	app.nodeKeeper = node.NewKeeper(app.cdc, keys[node.StoreKey], app.paramsKeepr, app.supplyKeeper)
 	app.bonddocKeeper = bonddoc.NewKeeper(app.cdc, keys[bonddoc.StoreKey])
 	app.bondsKeeper = bonds.NewKeeper(app.bankKeeper, app.supplyKeeper, app.accountKeeper, app.stakingKeeper, keys[bonds.StoreKey], app.cdc)
 
-	newEthClient, cErr := ixo.NewEthClient(app.contractKeeper)
-	if cErr != nil {
-		panic(cErr)
-	}
-
-	app.ethClient = newEthClient
-
 	app.mm = module.NewManager(
 		genaccounts.NewAppModule(app.accountKeeper),
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx),
@@ -210,15 +208,16 @@ func NewIxoApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		contracts.NewAppModule(app.contractKeeper),
 		did.NewAppModule(app.didKeeper),
 		fees.NewAppModule(app.feesKeeper),
-		node.NewAppModule(app.nodeKeeper),
+		node.NewAppModule(app.nodeKeeper, app.didKeeper),
 		params.NewAppModule(app.paramsKeepr),
 		project.NewAppModule(app.projectKeeper, app.feesKeeper,
-			app.contractKeeper, app.bankKeeper, app.paramsKeepr, app.ethClient),
+			app.contractKeeper, app.nodeKeeper, app.bankKeeper),
 		bonddoc.NewAppModule(app.bonddocKeeper),
 		bonds.NewAppModule(app.bondsKeeper, app.accountKeeper),
 	)
 
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distribution.ModuleName, slashing.ModuleName, bonds.ModuleName)
+	app.mm.SetOrderBeginBlockers(mint.ModuleName, distribution.ModuleName, slashing.ModuleName, bonds.ModuleName,
+		contracts.ModuleName, project.ModuleName)
 	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName, bonds.ModuleName)
 
 	app.mm.SetOrderInitGenesis(genaccounts.ModuleName, distribution.ModuleName,
@@ -296,8 +295,9 @@ func NewIxoAnteHandler(app *ixoApp) sdk.AnteHandler {
 	cosmosAnteHandler := auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
 	didAnteHandler := did.NewAnteHandler(app.didKeeper)
 	projectAnteHandler := project.NewAnteHandler(app.projectKeeper, app.didKeeper)
-	bonddocAnteHandler := bonddoc.NewAnteHandler(app.bonddocKeeper)
+	bonddocAnteHandler := bonddoc.NewAnteHandler(app.bonddocKeeper, app.didKeeper)
 	bondsAnteHandler := bonds.NewAnteHandler(app.bondsKeeper, app.didKeeper)
+	nodeAnteHandler := node.NewAnteHandler(app.didKeeper)
 
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (_ sdk.Context, _ sdk.Result, abort bool) {
 		msg := tx.GetMsgs()[0]
@@ -310,8 +310,10 @@ func NewIxoAnteHandler(app *ixoApp) sdk.AnteHandler {
 			return bonddocAnteHandler(ctx, tx, false)
 		case bonds.ModuleName:
 			return bondsAnteHandler(ctx, tx, false)
+		case node.ModuleName:
+			return nodeAnteHandler(ctx, tx, false)
 		default:
-			return cosmosAnteHandler(ctx, tx, true)
+			return cosmosAnteHandler(ctx, tx, simulate)
 		}
 	}
 }
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ixofoundation/ixo-cosmos/x/project"
+)
+
+func signStdTx(ctx sdk.Context, acc auth.Account, msg sdk.Msg, fee auth.StdFee, privKey crypto.PrivKey) auth.StdTx {
+	signBytes := auth.StdSignBytes(ctx.ChainID(), acc.GetAccountNumber(), acc.GetSequence(), fee, []sdk.Msg{msg}, "")
+	sig, err := privKey.Sign(signBytes)
+	if err != nil {
+		panic(err)
+	}
+
+	return auth.NewStdTx([]sdk.Msg{msg}, fee, []auth.StdSignature{{PubKey: privKey.PubKey(), Signature: sig}}, "")
+}
+
+func TestAnteHandlerRejectsForgedEthDepositAttestations(t *testing.T) {
+	app := NewIxoApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	ctx := app.NewContext(true, abciTypes.Header{})
+	app.accountKeeper.SetParams(ctx, auth.DefaultParams())
+	anteHandler := NewIxoAnteHandler(app)
+
+	validatorKey := secp256k1.GenPrivKey()
+	validatorAddr := sdk.AccAddress(validatorKey.PubKey().Address())
+	acc := app.accountKeeper.NewAccountWithAddress(ctx, validatorAddr)
+	app.accountKeeper.SetAccount(ctx, acc)
+
+	msg := project.MsgAttestEthDeposit{
+		Validator:        sdk.ValAddress(validatorAddr),
+		EthTxHash:        "0x1e7f1a5a0d32b1b0ce1a7d8a08e3bd8e11b7de1c6b0b8e4e1e5b7a6b5f6e2c3d",
+		ProjectEthWallet: "0x9e3c2d4e1b6a7f8c9d0e1f2a3b4c5d6e7f8a9b0c",
+		Amount:           sdk.NewInt(1000),
+	}
+	fee := auth.NewStdFee(200000, sdk.NewCoins())
+
+	// Unsigned attestations are rejected
+	unsignedTx := auth.NewStdTx([]sdk.Msg{msg}, fee, nil, "")
+	_, res, abort := anteHandler(ctx, unsignedTx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeNoSignatures, res.Code)
+
+	// Attestations signed by someone other than the validator are rejected
+	forgedTx := signStdTx(ctx, acc, msg, fee, secp256k1.GenPrivKey())
+	_, res, abort = anteHandler(ctx, forgedTx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeInvalidPubKey, res.Code)
+
+	// Attestations signed with the validator's key but over other sign bytes
+	// are rejected
+	wrongBytesTx := signStdTx(ctx, acc, msg, fee, validatorKey)
+	wrongBytesTx.Memo = "changed after signing"
+	_, res, abort = anteHandler(ctx, wrongBytesTx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+
+	signedTx := signStdTx(ctx, acc, msg, fee, validatorKey)
+	_, res, abort = anteHandler(ctx, signedTx, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+}
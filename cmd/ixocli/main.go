@@ -19,6 +19,7 @@ import (
 	
 	"github.com/ixofoundation/ixo-cosmos/app"
 	ixoClient "github.com/ixofoundation/ixo-cosmos/client"
+	didCli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
 )
 
 func main() {
@@ -49,6 +50,7 @@ func main() {
 		lcd.ServeCommand(cdc, registerRoutes),
 		client.LineBreak,
 		keys.Commands(),
+		didCli.GetDidCmd(cdc),
 		client.LineBreak,
 	)
 	
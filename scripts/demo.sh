@@ -17,7 +17,7 @@ wait() {
 tx() {
   cmd=$1
   shift
-  ixocli tx bonds "$cmd" --broadcast-mode block "$@"
+  yes $PASSWORD | ixocli tx bonds "$cmd" --broadcast-mode block "$@"
 }
 
 RET=$(ixocli status 2>&1)
@@ -36,14 +36,24 @@ MIGUEL_DID="4XJLBfGtWSGKSz4BeRxdun"
 MIGUEL_DID_FULL="{\"did\":\"4XJLBfGtWSGKSz4BeRxdun\",\"verifyKey\":\"2vMHhssdhrBCRFiq9vj7TxGYDybW4yYdrYh9JG56RaAt\",\"encryptionPublicKey\":\"6GBp8qYgjE3ducksUa9Ar26ganhDFcmYfbZE9ezFx5xS\",\"secret\":{\"seed\":\"38734eeb53b5d69177da1fa9a093f10d218b3e0f81087226be6ce0cdce478180\",\"signKey\":\"4oMozrMR6BXRN93MDk6UYoqBVBLiPn9RnZhR3wQd6tBh\",\"encryptionPrivateKey\":\"4oMozrMR6BXRN93MDk6UYoqBVBLiPn9RnZhR3wQd6tBh\"}}"
 FRANCESCO_DID_FULL="{\"did\":\"UKzkhVSHc3qEFva5EY2XHt\",\"verifyKey\":\"Ftsqjc2pEvGLqBtgvVx69VXLe1dj2mFzoi4kqQNGo3Ej\",\"encryptionPublicKey\":\"8YScf3mY4eeHoxDT9MRxiuGX5Fw7edWFnwHpgWYSn1si\",\"secret\":{\"seed\":\"94f3c48a9b19b4881e582ba80f5767cd3f3c5d7b7103cb9a50fa018f108d89de\",\"signKey\":\"B2Svs8GoQnUJHg8W2Ch7J53Goq36AaF6C6W4PD2MCPrM\",\"encryptionPrivateKey\":\"B2Svs8GoQnUJHg8W2Ch7J53Goq36AaF6C6W4PD2MCPrM\"}}"
 
+# Store the DIDs in the keyring, so that txs are signed with --from-did
+import_did() {
+  echo "$2" > "/tmp/$1.json"
+  yes $PASSWORD | ixocli did keys import "$1" "/tmp/$1.json"
+  rm "/tmp/$1.json"
+}
+import_did bond "$BOND_DID"
+import_did miguel "$MIGUEL_DID_FULL"
+import_did francesco "$FRANCESCO_DID_FULL"
+
 # Ledger DIDs
 echo "Ledgering DID 1/2..."
-ixocli tx did addDidDoc "$MIGUEL_DID_FULL" --broadcast-mode block
+yes $PASSWORD | ixocli tx did addDidDoc --from-did miguel --broadcast-mode block
 echo "Ledgering DID 2/2..."
-ixocli tx did addDidDoc "$FRANCESCO_DID_FULL" --broadcast-mode block
+yes $PASSWORD | ixocli tx did addDidDoc --from-did francesco --broadcast-mode block
 
 echo "Creating bond..."
-ixocli tx bonds create-bond \
+yes $PASSWORD | ixocli tx bonds create-bond \
   --token=abc \
   --name="A B C" \
   --description="Description about A B C" \
@@ -59,38 +69,38 @@ ixocli tx bonds create-bond \
   --sanity-margin-percentage="" \
   --allow-sells=true \
   --batch-blocks=1 \
-  --bond-did="$BOND_DID" \
+  --from-did=bond \
   --creator-did="$MIGUEL_DID" \
   --broadcast-mode block
 echo "Created bond..."
 ixocli query bonds bond U7GK8p8rVhJMKhBVRCJJ8c
 
 echo "Editing bond..."
-ixocli tx bonds edit-bond \
+yes $PASSWORD | ixocli tx bonds edit-bond \
   --token=abc \
   --name="New A B C" \
-  --bond-did="$BOND_DID" \
+  --from-did=bond \
   --editor-did="$MIGUEL_DID" \
   --broadcast-mode block
 echo "Edited bond..."
 ixocli query bonds bond U7GK8p8rVhJMKhBVRCJJ8c
 
 echo "Miguel buys 10abc..."
-tx buy 10abc 1000000res U7GK8p8rVhJMKhBVRCJJ8c "$MIGUEL_DID_FULL"
+tx buy 10abc 1000000res U7GK8p8rVhJMKhBVRCJJ8c --from-did miguel
 echo "Miguel's account..."
 ixocli query auth account "$MIGUEL_ADDR"
 
 echo "Francesco buys 10abc..."
-tx buy 10abc 1000000res U7GK8p8rVhJMKhBVRCJJ8c "$FRANCESCO_DID_FULL"
+tx buy 10abc 1000000res U7GK8p8rVhJMKhBVRCJJ8c --from-did francesco
 echo "Francesco's account..."
 ixocli query auth account "$FRANCESCO_ADDR"
 
 echo "Miguel sells 10abc..."
-tx sell 10abc U7GK8p8rVhJMKhBVRCJJ8c "$MIGUEL_DID_FULL"
+tx sell 10abc U7GK8p8rVhJMKhBVRCJJ8c --from-did miguel
 echo "Miguel's account..."
 ixocli query auth account "$MIGUEL_ADDR"
 
 echo "Francesco sells 10abc..."
-tx sell 10abc U7GK8p8rVhJMKhBVRCJJ8c "$FRANCESCO_DID_FULL"
+tx sell 10abc U7GK8p8rVhJMKhBVRCJJ8c --from-did francesco
 echo "Francesco's account..."
 ixocli query auth account "$FRANCESCO_ADDR"
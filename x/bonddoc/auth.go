@@ -1,14 +1,15 @@
 package bonddoc
 
 import (
-	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/ixofoundation/ixo-cosmos/x/bonddoc/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/did"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
 
-func NewAnteHandler(bonddocKeeper Keeper) sdk.AnteHandler {
+func NewAnteHandler(bonddocKeeper Keeper, didKeeper did.Keeper) sdk.AnteHandler {
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (_ sdk.Context, _ sdk.Result, abort bool) {
 
 		ixoTx, ok := tx.(ixo.IxoTx)
@@ -18,11 +19,11 @@ func NewAnteHandler(bonddocKeeper Keeper) sdk.AnteHandler {
 
 		msg := ixoTx.GetMsgs()[0]
 		bondMsg := msg.(types.BondMsg)
-		pubKey := [32]byte{}
+		var pubKeys []crypto.PubKey
 
 		if bondMsg.IsNewDid() {
 			createBondMsg := msg.(types.CreateBondMsg)
-			copy(pubKey[:], base58.Decode(createBondMsg.GetPubKey()))
+			pubKeys = []crypto.PubKey{ixo.Ed25519PubKey(createBondMsg.GetPubKey())}
 
 		} else {
 			bondDid := ixo.Did(msg.GetSigners()[0])
@@ -31,7 +32,10 @@ func NewAnteHandler(bonddocKeeper Keeper) sdk.AnteHandler {
 				return ctx, sdk.ErrInternal("bond did not found").Result(), false
 			}
 
-			copy(pubKey[:], base58.Decode(bondDoc.GetPubKey()))
+			// The bond DID doc, if any, adds its own keys and those of its
+			// controllers and delegates
+			authKeys, _ := didKeeper.GetSigningKeys(ctx, bondDid, msg)
+			pubKeys = append([]crypto.PubKey{ixo.Ed25519PubKey(bondDoc.GetPubKey())}, authKeys...)
 		}
 
 		var sigs = ixoTx.GetSignatures()
@@ -40,7 +44,7 @@ func NewAnteHandler(bonddocKeeper Keeper) sdk.AnteHandler {
 				sdk.ErrUnauthorized("there can only be one signer").Result(),
 				true
 		}
-		res := ixo.VerifySignature(msg, pubKey, sigs[0])
+		res := ixo.VerifySignatureWithAnyPubKey(msg, pubKeys, sigs[0])
 
 		if !res {
 			return ctx, sdk.ErrInternal("Signature Verification failed").Result(), true
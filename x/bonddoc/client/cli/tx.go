@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/btcsuite/btcutil/base58"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,21 +11,17 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ixofoundation/ixo-cosmos/x/bonddoc/internal/types"
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
 )
 
 func IxoSignAndBroadcast(cdc *codec.Codec, ctx context.CLIContext, msg sdk.Msg, sovrinDid sovrin.SovrinDid) error {
-	privKey := [64]byte{}
-	copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
-	copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
-
-	msgBytes, err := json.Marshal(msg)
+	signature, err := didcli.SignIxoMsg(msg, sovrinDid)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	signature := ixo.SignIxoMessage(msgBytes, sovrinDid.Did, privKey)
 	tx := ixo.NewIxoTxSingleMsg(msg, signature)
 
 	bz, err := cdc.MarshalJSON(tx)
@@ -45,25 +40,16 @@ func IxoSignAndBroadcast(cdc *codec.Codec, ctx context.CLIContext, msg sdk.Msg,
 
 }
 
-func unmarshalSovrinDID(sovrinJson string) sovrin.SovrinDid {
-	sovrinDid := sovrin.SovrinDid{}
-	sovrinErr := json.Unmarshal([]byte(sovrinJson), &sovrinDid)
-	if sovrinErr != nil {
-		panic(sovrinErr)
-	}
-
-	return sovrinDid
-}
-
 func CreateBondCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "createBond [bond-json] [sovrin-did]",
+		Use:   "createBond [bond-json]",
 		Short: "Create a new BondDoc signed by the sovrinDID of the bond",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+			if len(args[0]) == 0 {
 				return errors.New("You must provide the bond data and the bonds private key")
 			}
 
@@ -73,7 +59,11 @@ func CreateBondCmd(cdc *codec.Codec) *cobra.Command {
 				panic(err)
 			}
 
-			sovrinDid := unmarshalSovrinDID(args[1])
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewCreateBondMsg(bondDoc, sovrinDid)
 
 			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
@@ -83,13 +73,14 @@ func CreateBondCmd(cdc *codec.Codec) *cobra.Command {
 
 func UpdateBondStatusCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "updateBondStatus [tx-hash] [sender-did] [status] [sovrin-did]",
+		Use:   "updateBondStatus [tx-hash] [sender-did] [status]",
 		Short: "Update the status of a bond signed by the sovrinDID of the bond",
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 4 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 {
+			if len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 {
 				return errors.New("You must provide the status and the bonds private key")
 			}
 
@@ -111,7 +102,11 @@ func UpdateBondStatusCmd(cdc *codec.Codec) *cobra.Command {
 				Status: bondStatus,
 			}
 
-			sovrinDid := unmarshalSovrinDID(args[3])
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewUpdateBondStatusMsg(txHash, senderDid, updateBondStatusDoc, sovrinDid)
 
 			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
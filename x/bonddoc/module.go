@@ -15,6 +15,7 @@ import (
 	"github.com/ixofoundation/ixo-cosmos/x/bonddoc/client/cli"
 	"github.com/ixofoundation/ixo-cosmos/x/bonddoc/client/rest"
 	"github.com/ixofoundation/ixo-cosmos/x/bonddoc/internal/keeper"
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
 )
 
 var (
@@ -53,10 +54,10 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	bondTxCmd.AddCommand(client.PostCommands(
+	bondTxCmd.AddCommand(client.PostCommands(didcli.FromDidCommands(
 		cli.CreateBondCmd(cdc),
 		cli.UpdateBondStatusCmd(cdc),
-	)...)
+	)...)...)
 
 	return bondTxCmd
 }
@@ -7,13 +7,19 @@ import (
 
 //noinspection GoUnusedConst
 const (
-	QueryBonds          = keeper.QueryBonds
-	QueryBond           = keeper.QueryBond
-	QueryCurrentPrice   = keeper.QueryCurrentPrice
-	QueryCurrentReserve = keeper.QueryCurrentReserve
-	QueryCustomPrice    = keeper.QueryCustomPrice
-	QueryBuyPrice       = keeper.QueryBuyPrice
-	QuerySellReturn     = keeper.QuerySellReturn
+	QueryBonds            = keeper.QueryBonds
+	QueryBond             = keeper.QueryBond
+	QueryCurrentPrice     = keeper.QueryCurrentPrice
+	QueryCurrentReserve   = keeper.QueryCurrentReserve
+	QueryCustomPrice      = keeper.QueryCustomPrice
+	QueryBuyPrice         = keeper.QueryBuyPrice
+	QuerySellReturn       = keeper.QuerySellReturn
+	QueryPriceHistory     = keeper.QueryPriceHistory
+	QueryCandles          = keeper.QueryCandles
+	QueryAccountOrders    = keeper.QueryAccountOrders
+	QueryBondOrders       = keeper.QueryBondOrders
+	QueryEditHistory      = keeper.QueryEditHistory
+	QueryPendingFeeChange = keeper.QueryPendingFeeChange
 
 	DefaultCodeSpace = types.DefaultCodespace
 
@@ -43,6 +49,12 @@ const (
 	CodeSanityRateViolated                   = types.CodeSanityRateViolated
 	CodeFeeTooLarge                          = types.CodeFeeTooLarge
 
+	PowerFunction           = types.PowerFunction
+	SigmoidFunction         = types.SigmoidFunction
+	SwapperFunction         = types.SwapperFunction
+	WeightedSwapperFunction = types.WeightedSwapperFunction
+	DoNotModifyField        = types.DoNotModifyField
+
 	BondsMintBurnAccount       = types.BondsMintBurnAccount
 	BatchesIntermediaryAccount = types.BatchesIntermediaryAccount
 
@@ -70,6 +82,7 @@ var (
 	ErrArgumentMissingOrNonUInteger         = types.ErrArgumentMissingOrNonUInteger
 	ErrArgumentMissingOrNonBoolean          = types.ErrArgumentMissingOrNonBoolean
 	ErrIncorrectNumberOfReserveTokens       = types.ErrIncorrectNumberOfReserveTokens
+	ErrTooFewReserveTokens                  = types.ErrTooFewReserveTokens
 	ErrIncorrectNumberOfFunctionParameters  = types.ErrIncorrectNumberOfFunctionParameters
 	ErrBondDoesNotExist                     = types.ErrBondDoesNotExist
 	ErrBondAlreadyExists                    = types.ErrBondAlreadyExists
@@ -77,6 +90,7 @@ var (
 	ErrDidNotEditAnything                   = types.ErrDidNotEditAnything
 	ErrUnrecognizedFunctionType             = types.ErrUnrecognizedFunctionType
 	ErrInvalidFunctionParameter             = types.ErrInvalidFunctionParameter
+	ErrReserveTokenWeightMissing            = types.ErrReserveTokenWeightMissing
 	ErrFunctionNotAvailableForFunctionType  = types.ErrFunctionNotAvailableForFunctionType
 	ErrFunctionRequiresNonZeroCurrentSupply = types.ErrFunctionRequiresNonZeroCurrentSupply
 	ErrTokenIsNotAValidReserveToken         = types.ErrTokenIsNotAValidReserveToken
@@ -94,6 +108,8 @@ var (
 	ErrOrderQuantityLimitExceeded           = types.ErrOrderQuantityLimitExceeded
 	ErrValuesViolateSanityRate              = types.ErrValuesViolateSanityRate
 	ErrFeesCannotBeOrExceed100Percent       = types.ErrFeesCannotBeOrExceed100Percent
+	ErrEditorIsNotBondCreator               = types.ErrEditorIsNotBondCreator
+	ErrMaxSupplyBelowSupply                 = types.ErrMaxSupplyBelowSupply
 
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
@@ -101,30 +117,50 @@ var (
 
 	SquareRootDec       = types.SquareRootDec
 	SquareRootInt       = types.SquareRootInt
+	PowerDec            = types.PowerDec
+	ApproxRootDec       = types.ApproxRootDec
+	ApproxPowerDec      = types.ApproxPowerDec
 	RoundReservePrice   = types.RoundReservePrice
 	RoundReserveReturn  = types.RoundReserveReturn
 	RoundFee            = types.RoundFee
 	RoundReservePrices  = types.RoundReservePrices
 	RoundReserveReturns = types.RoundReserveReturns
-
-	NewFunctionParam = types.NewFunctionParam
-	NewBond          = types.NewBond
-	NewBatch         = types.NewBatch
-	NewBaseOrder     = types.NewBaseOrder
-	NewBuyOrder      = types.NewBuyOrder
-	NewSellOrder     = types.NewSellOrder
-	NewSwapOrder     = types.NewSwapOrder
-	NewMsgCreateBond = types.NewMsgCreateBond
-	NewMsgEditBond   = types.NewMsgEditBond
-	NewMsgBuy        = types.NewMsgBuy
-	NewMsgSell       = types.NewMsgSell
-	NewMsgSwap       = types.NewMsgSwap
+	CheckReserveWeights = types.CheckReserveWeights
+
+	NewFunctionParam       = types.NewFunctionParam
+	NewBond                = types.NewBond
+	NewBatch               = types.NewBatch
+	NewBaseOrder           = types.NewBaseOrder
+	NewBuyOrder            = types.NewBuyOrder
+	NewSellOrder           = types.NewSellOrder
+	NewSwapOrder           = types.NewSwapOrder
+	NewPricePoint          = types.NewPricePoint
+	NewCandle              = types.NewCandle
+	GetCandles             = types.GetCandles
+	NewFulfilledBuyRecord  = types.NewFulfilledBuyRecord
+	NewFulfilledSellRecord = types.NewFulfilledSellRecord
+	NewFulfilledSwapRecord = types.NewFulfilledSwapRecord
+	NewCancelledBuyRecord  = types.NewCancelledBuyRecord
+	NewCancelledSwapRecord = types.NewCancelledSwapRecord
+	NewPendingFeeChange    = types.NewPendingFeeChange
+	NewBondEdit            = types.NewBondEdit
+	NewMsgCreateBond       = types.NewMsgCreateBond
+	NewMsgEditBond         = types.NewMsgEditBond
+	NewMsgBuy              = types.NewMsgBuy
+	NewMsgSell             = types.NewMsgSell
+	NewMsgSwap             = types.NewMsgSwap
 
 	// variable aliases
-	ModuleCdc            = types.ModuleCdc
-	BondsKeyPrefix       = types.BondsKeyPrefix
-	BatchesKeyPrefix     = types.BatchesKeyPrefix
-	LastBatchesKeyPrefix = types.LastBatchesKeyPrefix
+	ModuleCdc                  = types.ModuleCdc
+	BondsKeyPrefix             = types.BondsKeyPrefix
+	BatchesKeyPrefix           = types.BatchesKeyPrefix
+	LastBatchesKeyPrefix       = types.LastBatchesKeyPrefix
+	PricePointsKeyPrefix       = types.PricePointsKeyPrefix
+	OrderRecordsKeyPrefix      = types.OrderRecordsKeyPrefix
+	AccountOrdersKeyPrefix     = types.AccountOrdersKeyPrefix
+	BondOrdersKeyPrefix        = types.BondOrdersKeyPrefix
+	PendingFeeChangesKeyPrefix = types.PendingFeeChangesKeyPrefix
+	BondEditsKeyPrefix         = types.BondEditsKeyPrefix
 )
 
 type (
@@ -138,17 +174,23 @@ type (
 	MsgSell       = types.MsgSell
 	MsgSwap       = types.MsgSwap
 
-	FunctionParam  = types.FunctionParam
-	FunctionParams = types.FunctionParams
-	Bond           = types.Bond
-	Batch          = types.Batch
-	Order          = types.BaseOrder
-	BuyOrder       = types.BuyOrder
-	SellOrder      = types.SellOrder
-	SwapOrder      = types.SwapOrder
-
-	QueryResBonds      = types.QueryBonds
-	QueryResBuyPrice   = types.QueryBuyPrice
-	QueryResSellReturn = types.QuerySellReturn
-	QueryResSwapReturn = types.QuerySwapReturn
+	FunctionParam    = types.FunctionParam
+	FunctionParams   = types.FunctionParams
+	Bond             = types.Bond
+	Batch            = types.Batch
+	Order            = types.BaseOrder
+	BuyOrder         = types.BuyOrder
+	SellOrder        = types.SellOrder
+	SwapOrder        = types.SwapOrder
+	PricePoint       = types.PricePoint
+	Candle           = types.Candle
+	OrderRecord      = types.OrderRecord
+	PendingFeeChange = types.PendingFeeChange
+	BondEdit         = types.BondEdit
+
+	QueryResBonds        = types.QueryBonds
+	QueryResBuyPrice     = types.QueryBuyPrice
+	QueryResSellReturn   = types.QuerySellReturn
+	QueryResSwapReturn   = types.QuerySwapReturn
+	QueryResOrderHistory = types.QueryOrderHistory
 )
@@ -1,11 +1,11 @@
 package bonds
 
 import (
-	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
 	"github.com/ixofoundation/ixo-cosmos/x/did"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 func NewAnteHandler(bondsKeeper Keeper, didKeeper did.Keeper) sdk.AnteHandler {
@@ -17,14 +17,14 @@ func NewAnteHandler(bondsKeeper Keeper, didKeeper did.Keeper) sdk.AnteHandler {
 		}
 
 		msg := ixoTx.GetMsgs()[0]
-		pubKey := [32]byte{}
+		var pubKeys []crypto.PubKey
 		var senderDid ixo.Did
 
 		// Get signer PubKey and sender DID
 		switch msg := msg.(type) {
 		case types.MsgCreateBond:
 			senderDid = msg.CreatorDid
-			copy(pubKey[:], base58.Decode(msg.PubKey))
+			pubKeys = []crypto.PubKey{ixo.Ed25519PubKey(msg.PubKey)}
 		case types.MsgEditBond:
 			senderDid = msg.EditorDid
 			bondDid := ixo.Did(msg.GetSigners()[0])
@@ -32,16 +32,19 @@ func NewAnteHandler(bondsKeeper Keeper, didKeeper did.Keeper) sdk.AnteHandler {
 			if !found {
 				return ctx, sdk.ErrInternal("bond not found").Result(), true
 			}
-			copy(pubKey[:], base58.Decode(bond.PubKey))
+			authKeys, _ := didKeeper.GetSigningKeys(ctx, bondDid, msg)
+			pubKeys = append([]crypto.PubKey{ixo.Ed25519PubKey(bond.PubKey)}, authKeys...)
+		// The msg PubKey is not tied to the sender DID, so only the keys of the
+		// ledgered DID doc, its controllers and its delegates can sign
 		case types.MsgBuy:
 			senderDid = msg.BuyerDid
-			copy(pubKey[:], base58.Decode(msg.PubKey))
+			pubKeys, _ = didKeeper.GetSigningKeys(ctx, senderDid, msg)
 		case types.MsgSell:
 			senderDid = msg.SellerDid
-			copy(pubKey[:], base58.Decode(msg.PubKey))
+			pubKeys, _ = didKeeper.GetSigningKeys(ctx, senderDid, msg)
 		case types.MsgSwap:
 			senderDid = msg.SwapperDid
-			copy(pubKey[:], base58.Decode(msg.PubKey))
+			pubKeys, _ = didKeeper.GetSigningKeys(ctx, senderDid, msg)
 		default:
 			panic("Unrecognized message type")
 		}
@@ -60,7 +63,7 @@ func NewAnteHandler(bondsKeeper Keeper, didKeeper did.Keeper) sdk.AnteHandler {
 				sdk.ErrUnauthorized("there can only be one signer").Result(),
 				true
 		}
-		res := ixo.VerifySignature(msg, pubKey, sigs[0])
+		res := ixo.VerifySignatureWithAnyPubKey(msg, pubKeys, sigs[0])
 
 		if !res {
 			return ctx, sdk.ErrInternal("Signature Verification failed").Result(), true
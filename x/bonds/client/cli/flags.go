@@ -21,7 +21,6 @@ const (
 	FlagSanityMarginPercentage = "sanity-margin-percentage"
 	FlagAllowSells             = "allow-sells"
 	FlagBatchBlocks            = "batch-blocks"
-	FlagBondDid                = "bond-did"
 	FlagCreatorDid             = "creator-did"
 	FlagEditorDid              = "editor-did"
 )
@@ -50,7 +49,6 @@ func init() {
 	fsBondCreate.String(FlagSanityMarginPercentage, "", "For swappers, this is the acceptable deviation from the sanity rate")
 	fsBondCreate.String(FlagAllowSells, "", "Whether or not sells will be allowed")
 	fsBondCreate.String(FlagBatchBlocks, "", "The duration in terms of blocks of each orders batch")
-	fsBondCreate.String(FlagBondDid, "", "Bond's Sovrin DID")
 	fsBondCreate.String(FlagCreatorDid, "", "Bond creator's DID")
 
 	fsBondEdit.String(FlagName, types.DoNotModifyField, "The bond's name")
@@ -58,6 +56,10 @@ func init() {
 	fsBondEdit.String(FlagOrderQuantityLimits, types.DoNotModifyField, "The max number of tokens bought/sold/swapped per order")
 	fsBondEdit.String(FlagSanityRate, types.DoNotModifyField, "For swappers, this is the typical t1 per t2 rate")
 	fsBondEdit.String(FlagSanityMarginPercentage, types.DoNotModifyField, "For swappers, this is the acceptable deviation from the sanity rate")
-	fsBondEdit.String(FlagBondDid, "", "Bond's Sovrin DID")
+	fsBondEdit.String(FlagTxFeePercentage, types.DoNotModifyField, "The percentage fee charged on buys and sells")
+	fsBondEdit.String(FlagExitFeePercentage, types.DoNotModifyField, "The percentage fee charged on sells")
+	fsBondEdit.String(FlagFeeAddress, types.DoNotModifyField, "The address that will hold any charged fees")
+	fsBondEdit.String(FlagMaxSupply, types.DoNotModifyField, "The maximum supply that can be achieved")
+	fsBondEdit.String(FlagBatchBlocks, types.DoNotModifyField, "The duration in terms of blocks of each orders batch")
 	fsBondEdit.String(FlagEditorDid, "", "Bond editor's DID")
 }
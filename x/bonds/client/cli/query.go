@@ -31,6 +31,12 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdBuyPrice(storeKey, cdc),
 		GetCmdSellReturn(storeKey, cdc),
 		GetCmdSwapReturn(storeKey, cdc),
+		GetCmdPriceHistory(storeKey, cdc),
+		GetCmdCandles(storeKey, cdc),
+		GetCmdAccountOrders(storeKey, cdc),
+		GetCmdBondOrders(storeKey, cdc),
+		GetCmdEditHistory(storeKey, cdc),
+		GetCmdPendingFeeChange(storeKey, cdc),
 	)...)
 
 	return bondsQueryCmd
@@ -402,3 +408,224 @@ func GetCmdSwapReturn(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdPriceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "price-history [bond-did] [from-height] [to-height]",
+		Example: "price-history U7GK8p8rVhJMKhBVRCJJ8c 100 200",
+		Short:   "Query price points of a bond recorded between two block heights",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondDid := args[0]
+			fromHeight := args[1]
+			toHeight := args[2]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/price_history/%s/%s/%s",
+					queryRoute, bondDid, fromHeight, toHeight), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out []types.PricePoint
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdCandles(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "candles [bond-did] [reserve-token] [from-height] [to-height] [interval]",
+		Example: "candles U7GK8p8rVhJMKhBVRCJJ8c res 100 200 10",
+		Short:   "Query OHLC candles of a bond's buy price in a reserve token",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondDid := args[0]
+			reserveToken := args[1]
+			fromHeight := args[2]
+			toHeight := args[3]
+			interval := args[4]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/candles/%s/%s/%s/%s/%s",
+					queryRoute, bondDid, reserveToken, fromHeight,
+					toHeight, interval), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out []types.Candle
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdAccountOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "account-orders [account-did] [page] [limit]",
+		Example: "account-orders U7GK8p8rVhJMKhBVRCJJ8c 1 20",
+		Short:   "Query the fulfilled and cancelled orders of an account",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			accountDid := args[0]
+			page := args[1]
+			limit := args[2]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/account_orders/%s/%s/%s",
+					queryRoute, accountDid, page, limit), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryOrderHistory
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdBondOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "bond-orders [bond-did] [page] [limit]",
+		Example: "bond-orders U7GK8p8rVhJMKhBVRCJJ8c 1 20",
+		Short:   "Query the fulfilled and cancelled orders of a bond",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondDid := args[0]
+			page := args[1]
+			limit := args[2]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/bond_orders/%s/%s/%s",
+					queryRoute, bondDid, page, limit), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.QueryOrderHistory
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdEditHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "edit-history [bond-did]",
+		Example: "edit-history U7GK8p8rVhJMKhBVRCJJ8c",
+		Short:   "Query the edit history of a bond",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondDid := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/edit_history/%s",
+					queryRoute, bondDid), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out []types.BondEdit
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCmdPendingFeeChange(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "pending-fee-change [bond-did]",
+		Example: "pending-fee-change U7GK8p8rVhJMKhBVRCJJ8c",
+		Short:   "Query a bond's fee increase that is waiting for its notice period to end",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			bondDid := args[0]
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/pending_fee_change/%s",
+					queryRoute, bondDid), nil)
+			if err != nil {
+				fmt.Printf("%s", err.Error())
+				return nil
+			}
+
+			var out types.PendingFeeChange
+			err = cdc.UnmarshalJSON(res, &out)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	client2 "github.com/ixofoundation/ixo-cosmos/x/bonds/client"
 	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -21,13 +22,13 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	bondsTxCmd.AddCommand(client.PostCommands(
+	bondsTxCmd.AddCommand(client.PostCommands(didcli.FromDidCommands(
 		GetCmdCreateBond(cdc),
 		GetCmdEditBond(cdc),
 		GetCmdBuy(cdc),
 		GetCmdSell(cdc),
 		GetCmdSwap(cdc),
-	)...)
+	)...)...)
 
 	return bondsTxCmd
 }
@@ -52,7 +53,6 @@ func GetCmdCreateBond(cdc *codec.Codec) *cobra.Command {
 			_sanityMarginPercentage := viper.GetString(FlagSanityMarginPercentage)
 			_allowSells := viper.GetString(FlagAllowSells)
 			_batchBlocks := viper.GetString(FlagBatchBlocks)
-			_bondDid := viper.GetString(FlagBondDid)
 			_creatorDid := viper.GetString(FlagCreatorDid)
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -116,8 +116,11 @@ func GetCmdCreateBond(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf(err.Error())
 			}
 
-			// Parse bond's sovrin DID
-			bondDid := client2.UnmarshalSovrinDID(_bondDid)
+			// Get bond's sovrin DID
+			bondDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgCreateBond(_token, _name, _description,
 				_creatorDid, _functionType, functionParams, reserveTokens,
@@ -147,7 +150,6 @@ func GetCmdCreateBond(cdc *codec.Codec) *cobra.Command {
 	_ = cmd.MarkFlagRequired(FlagSanityMarginPercentage)
 	_ = cmd.MarkFlagRequired(FlagAllowSells)
 	_ = cmd.MarkFlagRequired(FlagBatchBlocks)
-	_ = cmd.MarkFlagRequired(FlagBondDid)
 	_ = cmd.MarkFlagRequired(FlagCreatorDid)
 
 	return cmd
@@ -164,17 +166,25 @@ func GetCmdEditBond(cdc *codec.Codec) *cobra.Command {
 			_orderQuantityLimits := viper.GetString(FlagOrderQuantityLimits)
 			_sanityRate := viper.GetString(FlagSanityRate)
 			_sanityMarginPercentage := viper.GetString(FlagSanityMarginPercentage)
-			_bondDid := viper.GetString(FlagBondDid)
+			_txFeePercentage := viper.GetString(FlagTxFeePercentage)
+			_exitFeePercentage := viper.GetString(FlagExitFeePercentage)
+			_feeAddress := viper.GetString(FlagFeeAddress)
+			_maxSupply := viper.GetString(FlagMaxSupply)
+			_batchBlocks := viper.GetString(FlagBatchBlocks)
 			_editorDid := viper.GetString(FlagEditorDid)
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			// Parse bond's sovrin DID
-			bondDid := client2.UnmarshalSovrinDID(_bondDid)
+			// Get bond's sovrin DID
+			bondDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgEditBond(
 				_token, _name, _description, _orderQuantityLimits, _sanityRate,
-				_sanityMarginPercentage, _editorDid, bondDid)
+				_sanityMarginPercentage, _txFeePercentage, _exitFeePercentage,
+				_feeAddress, _maxSupply, _batchBlocks, _editorDid, bondDid)
 
 			return client2.IxoSignAndBroadcast(cdc, cliCtx, msg, bondDid)
 		},
@@ -184,7 +194,6 @@ func GetCmdEditBond(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsBondEdit)
 
 	_ = cmd.MarkFlagRequired(FlagToken)
-	_ = cmd.MarkFlagRequired(FlagBondDid)
 	_ = cmd.MarkFlagRequired(FlagEditorDid)
 
 	return cmd
@@ -192,12 +201,12 @@ func GetCmdEditBond(cdc *codec.Codec) *cobra.Command {
 
 func GetCmdBuy(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use: "buy [bond-token-with-amount] [max-prices] [bond-did] [buyer-did]",
+		Use: "buy [bond-token-with-amount] [max-prices] [bond-did]",
 		Example: "" +
-			"buy 10abc 1000res1 U7GK8p8rVhJMKhBVRCJJ8c <buyer-sovrin-did>\n" +
-			"buy 10abc 1000res1,1000res2 U7GK8p8rVhJMKhBVRCJJ8c <buyer-sovrin-did>",
+			"buy 10abc 1000res1 U7GK8p8rVhJMKhBVRCJJ8c --from-did <buyer-key-name>\n" +
+			"buy 10abc 1000res1,1000res2 U7GK8p8rVhJMKhBVRCJJ8c --from-did <buyer-key-name>",
 		Short: "Buy from a bond",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -212,7 +221,10 @@ func GetCmdBuy(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// Parse buyer's sovrin DID
-			buyerDid := client2.UnmarshalSovrinDID(args[3])
+			buyerDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgBuy(buyerDid, bondCoinWithAmount, maxPrices, args[2])
 
@@ -224,10 +236,10 @@ func GetCmdBuy(cdc *codec.Codec) *cobra.Command {
 
 func GetCmdSell(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "sell [bond-token-with-amount] [bond-did] [seller-did]",
-		Example: "sell 10abc U7GK8p8rVhJMKhBVRCJJ8c <seller-sovrin-did>",
+		Use:     "sell [bond-token-with-amount] [bond-did]",
+		Example: "sell 10abc U7GK8p8rVhJMKhBVRCJJ8c --from-did <seller-key-name>",
 		Short:   "Sell from a bond",
-		Args:    cobra.ExactArgs(3),
+		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -237,7 +249,10 @@ func GetCmdSell(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// Parse seller's sovrin DID
-			sellerDid := client2.UnmarshalSovrinDID(args[2])
+			sellerDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgSell(sellerDid, bondCoinWithAmount, args[1])
 
@@ -249,12 +264,12 @@ func GetCmdSell(cdc *codec.Codec) *cobra.Command {
 
 func GetCmdSwap(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use: "swap [from-amount] [from-token] [to-token] [bond-did] [swapper-did]",
+		Use: "swap [from-amount] [from-token] [to-token] [bond-did]",
 		Example: "" +
-			"swap 100 res1 res2 U7GK8p8rVhJMKhBVRCJJ8c <swapper-sovrin-did>\n" +
-			"swap 100 res2 res1 U7GK8p8rVhJMKhBVRCJJ8c <swapper-sovrin-did>",
+			"swap 100 res1 res2 U7GK8p8rVhJMKhBVRCJJ8c --from-did <swapper-key-name>\n" +
+			"swap 100 res2 res1 U7GK8p8rVhJMKhBVRCJJ8c --from-did <swapper-key-name>",
 		Short: "Perform a swap between two tokens",
-		Args:  cobra.ExactArgs(5),
+		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -271,7 +286,10 @@ func GetCmdSwap(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// Parse swapper's sovrin DID
-			swapperDid := client2.UnmarshalSovrinDID(args[4])
+			swapperDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgSwap(swapperDid, from, args[2], args[3])
 
@@ -3,13 +3,14 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	"github.com/btcsuite/btcutil/base58"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+	"sort"
 	"strings"
 )
 
@@ -63,18 +64,25 @@ func ParseFunctionParams(fnParamsStr string, fnType string) (fnParams types.Func
 		return nil, err
 	}
 
-	// Split (if not empty) and check number of parameters
+	// Split (if not empty) and parse function parameters into map
 	paramValuePairs := splitParameters(fnParamsStr)
-	if len(paramValuePairs) != len(expectedParams) {
-		return nil, types.ErrIncorrectNumberOfFunctionParameters(types.DefaultCodespace, len(expectedParams))
-	}
-
-	// Parse function parameters into map
 	paramsFieldMap, err := paramsListToMap(paramValuePairs)
 	if err != nil {
 		return nil, err
 	}
 
+	// Weighted swapper parameters are reserve token weights, so the expected
+	// parameters are the ones specified (checked against the reserve tokens
+	// when the message is validated). Otherwise, check number of parameters
+	if fnType == types.WeightedSwapperFunction {
+		for p := range paramsFieldMap {
+			expectedParams = append(expectedParams, p)
+		}
+		sort.Strings(expectedParams)
+	} else if len(paramValuePairs) != len(expectedParams) {
+		return nil, types.ErrIncorrectNumberOfFunctionParameters(types.DefaultCodespace, len(expectedParams))
+	}
+
 	// Parse parameters into integers
 	functionParams, err := paramsMapToObj(paramsFieldMap, expectedParams)
 	if err != nil {
@@ -208,16 +216,11 @@ func ParseCoin(amount, denom string) (coin sdk.Coin, err error) {
 }
 
 func IxoSignAndBroadcast(cdc *codec.Codec, ctx context.CLIContext, msg sdk.Msg, sovrinDid sovrin.SovrinDid) error {
-	privKey := [64]byte{}
-	copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
-	copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
-
-	msgBytes, err := json.Marshal(msg)
+	signature, err := didcli.SignIxoMsg(msg, sovrinDid)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	signature := ixo.SignIxoMessage(msgBytes, sovrinDid.Did, privKey)
 	tx := ixo.NewIxoTxSingleMsg(msg, signature)
 
 	bz, err := cdc.MarshalJSON(tx)
@@ -58,6 +58,36 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		fmt.Sprintf("/bonds/{%s}/swap_return/{%s}/{%s}", RestBondDid, RestFromTokenWithAmount, RestToToken),
 		querySwapReturnHandler(cliCtx, queryRoute),
 	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/price_history/{%s}/{%s}", RestBondDid, RestFromHeight, RestToHeight),
+		queryPriceHistoryHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/candles/{%s}/{%s}/{%s}/{%s}", RestBondDid, RestReserveToken, RestFromHeight, RestToHeight, RestInterval),
+		queryCandlesHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/orders/{%s}/{%s}", RestBondDid, RestPage, RestLimit),
+		queryBondOrdersHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/account/{%s}/orders/{%s}/{%s}", RestAccountDid, RestPage, RestLimit),
+		queryAccountOrdersHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/edit_history", RestBondDid),
+		queryEditHistoryHandler(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/bonds/{%s}/pending_fee_change", RestBondDid),
+		queryPendingFeeChangeHandler(cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func queryBondsHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -236,3 +266,116 @@ func querySwapReturnHandler(cliCtx context.CLIContext, queryRoute string) http.H
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryPriceHistoryHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondDid := vars[RestBondDid]
+		fromHeight := vars[RestFromHeight]
+		toHeight := vars[RestToHeight]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/price_history/%s/%s/%s",
+				queryRoute, bondDid, fromHeight, toHeight), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryCandlesHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondDid := vars[RestBondDid]
+		reserveToken := vars[RestReserveToken]
+		fromHeight := vars[RestFromHeight]
+		toHeight := vars[RestToHeight]
+		interval := vars[RestInterval]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/candles/%s/%s/%s/%s/%s",
+				queryRoute, bondDid, reserveToken, fromHeight, toHeight,
+				interval), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryAccountOrdersHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		accountDid := vars[RestAccountDid]
+		page := vars[RestPage]
+		limit := vars[RestLimit]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/account_orders/%s/%s/%s",
+				queryRoute, accountDid, page, limit), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryBondOrdersHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondDid := vars[RestBondDid]
+		page := vars[RestPage]
+		limit := vars[RestLimit]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/bond_orders/%s/%s/%s",
+				queryRoute, bondDid, page, limit), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryEditHistoryHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondDid := vars[RestBondDid]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/edit_history/%s",
+				queryRoute, bondDid), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryPendingFeeChangeHandler(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bondDid := vars[RestBondDid]
+
+		res, _, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/pending_fee_change/%s",
+				queryRoute, bondDid), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -14,6 +14,13 @@ const (
 	RestBondAmount          = "bond_amount"
 	RestFromTokenWithAmount = "from_token_with_amount"
 	RestToToken             = "to_token"
+	RestReserveToken        = "reserve_token"
+	RestFromHeight          = "from_height"
+	RestToHeight            = "to_height"
+	RestInterval            = "interval"
+	RestAccountDid          = "account_did"
+	RestPage                = "page"
+	RestLimit               = "limit"
 )
 
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, queryRoute string) {
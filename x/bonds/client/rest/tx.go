@@ -213,6 +213,11 @@ type editBondReq struct {
 	OrderQuantityLimits    string       `json:"order_quantity_limits" yaml:"order_quantity_limits"`
 	SanityRate             string       `json:"sanity_rate" yaml:"sanity_rate"`
 	SanityMarginPercentage string       `json:"sanity_margin_percentage" yaml:"sanity_margin_percentage"`
+	TxFeePercentage        string       `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
+	ExitFeePercentage      string       `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
+	FeeAddress             string       `json:"fee_address" yaml:"fee_address"`
+	MaxSupply              string       `json:"max_supply" yaml:"max_supply"`
+	BatchBlocks            string       `json:"batch_blocks" yaml:"batch_blocks"`
 	BondDid                string       `json:"bond_did" yaml:"bond_did"`
 	EditorDid              string       `json:"editor_did" yaml:"editor_did"`
 }
@@ -236,7 +241,9 @@ func editBondHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		msg := types.NewMsgEditBond(req.Token, req.Name, req.Description,
 			req.OrderQuantityLimits, req.SanityRate,
-			req.SanityMarginPercentage, req.EditorDid, bondDid)
+			req.SanityMarginPercentage, req.TxFeePercentage,
+			req.ExitFeePercentage, req.FeeAddress, req.MaxSupply,
+			req.BatchBlocks, req.EditorDid, bondDid)
 		err := msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -15,6 +15,29 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, b := range data.Batches {
 		keeper.SetBatch(ctx, b.BondDid, b)
 	}
+
+	// Initialise price history
+	for _, p := range data.PricePoints {
+		keeper.SetPricePoint(ctx, p)
+	}
+
+	// Initialise order history
+	var orderRecordCount uint64
+	for _, r := range data.OrderRecords {
+		keeper.SetOrderRecord(ctx, r)
+		if r.Id >= orderRecordCount {
+			orderRecordCount = r.Id + 1
+		}
+	}
+	keeper.SetOrderRecordCount(ctx, orderRecordCount)
+
+	// Initialise pending fee changes and edit history
+	for _, c := range data.PendingFeeChanges {
+		keeper.SetPendingFeeChange(ctx, c)
+	}
+	for _, e := range data.BondEdits {
+		keeper.AddBondEdit(ctx, e)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
@@ -30,7 +53,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	}
 
 	return GenesisState{
-		Bonds:   bonds,
-		Batches: batches,
+		Bonds:             bonds,
+		Batches:           batches,
+		PricePoints:       k.GetAllPricePoints(ctx),
+		OrderRecords:      k.GetAllOrderRecords(ctx),
+		PendingFeeChanges: k.GetAllPendingFeeChanges(ctx),
+		BondEdits:         k.GetAllBondEdits(ctx),
 	}
 }
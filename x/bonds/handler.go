@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/keeper"
 	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"strings"
 )
@@ -51,6 +52,12 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) []abci.ValidatorUpdate {
 		// Get batch again just in case orders were cancelled
 		batch = keeper.MustGetBatch(ctx, bond.BondDid)
 
+		// Record price point for price history
+		keeper.RecordPricePoint(ctx, bond.BondDid, batch)
+
+		// Count down and apply any pending fee change
+		keeper.ProgressPendingFeeChange(ctx, bond.BondDid)
+
 		// Save current as last and reset current
 		keeper.SetLastBatch(ctx, bond.BondDid, batch)
 		keeper.SetBatch(ctx, bond.BondDid, types.NewBatch(bond.BondDid, bond.Token, bond.BatchBlocks))
@@ -121,12 +128,23 @@ func handleMsgEditBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgEditB
 	bond, found := keeper.GetBond(ctx, msg.BondDid)
 	if !found {
 		return types.ErrBondDoesNotExist(types.DefaultCodespace, msg.BondDid).Result()
+	} else if msg.EditorDid != bond.CreatorDid {
+		return types.ErrEditorIsNotBondCreator(types.DefaultCodespace, msg.EditorDid).Result()
+	}
+
+	// Edits are recorded in the bond's edit history once all fields are valid
+	var edits []types.BondEdit
+	addEdit := func(field, oldValue, newValue string, noticeBatches uint64) {
+		edits = append(edits, types.NewBondEdit(bond.BondDid, msg.EditorDid,
+			ctx.BlockHeight(), field, oldValue, newValue, noticeBatches))
 	}
 
 	if msg.Name != types.DoNotModifyField {
+		addEdit(types.AttributeKeyName, bond.Name, msg.Name, 0)
 		bond.Name = msg.Name
 	}
 	if msg.Description != types.DoNotModifyField {
+		addEdit(types.AttributeKeyDescription, bond.Description, msg.Description, 0)
 		bond.Description = msg.Description
 	}
 
@@ -135,6 +153,8 @@ func handleMsgEditBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgEditB
 		if err != nil {
 			return sdk.ErrInternal(err.Error()).Result()
 		}
+		addEdit(types.AttributeKeyOrderQuantityLimits,
+			bond.OrderQuantityLimits.String(), orderQuantityLimits.String(), 0)
 		bond.OrderQuantityLimits = orderQuantityLimits
 	}
 
@@ -159,15 +179,124 @@ func handleMsgEditBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgEditB
 			sanityRate = parsedSanityRate
 			sanityMarginPercentage = parsedSanityMarginPercentage
 		}
+		addEdit(types.AttributeKeySanityRate, bond.SanityRate.String(), sanityRate.String(), 0)
+		addEdit(types.AttributeKeySanityMarginPercentage,
+			bond.SanityMarginPercentage.String(), sanityMarginPercentage.String(), 0)
 		bond.SanityRate = sanityRate
 		bond.SanityMarginPercentage = sanityMarginPercentage
 	}
 
+	// Fee decreases take effect immediately, whereas fee increases only take
+	// effect after a notice period, so that orders already in the batch (and
+	// orders in the next few batches) are not charged more than expected
+	pendingFeeChange, pendingFeeChangeFound := keeper.GetPendingFeeChange(ctx, bond.BondDid)
+	feesEdited := false
+	if msg.TxFeePercentage != types.DoNotModifyField || msg.ExitFeePercentage != types.DoNotModifyField {
+		// Unedited fees keep their pending value, if any
+		txFeePercentage, exitFeePercentage := bond.TxFeePercentage, bond.ExitFeePercentage
+		if pendingFeeChangeFound {
+			txFeePercentage = pendingFeeChange.TxFeePercentage
+			exitFeePercentage = pendingFeeChange.ExitFeePercentage
+		}
+
+		if msg.TxFeePercentage != types.DoNotModifyField {
+			parsedTxFeePercentage, err := sdk.NewDecFromStr(msg.TxFeePercentage)
+			if err != nil {
+				return types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "tx fee percentage").Result()
+			} else if parsedTxFeePercentage.IsNegative() {
+				return types.ErrArgumentCannotBeNegative(types.DefaultCodespace, "tx fee percentage").Result()
+			}
+			txFeePercentage = parsedTxFeePercentage
+		}
+		if msg.ExitFeePercentage != types.DoNotModifyField {
+			parsedExitFeePercentage, err := sdk.NewDecFromStr(msg.ExitFeePercentage)
+			if err != nil {
+				return types.ErrArgumentMissingOrNonFloat(types.DefaultCodespace, "exit fee percentage").Result()
+			} else if parsedExitFeePercentage.IsNegative() {
+				return types.ErrArgumentCannotBeNegative(types.DefaultCodespace, "exit fee percentage").Result()
+			}
+			exitFeePercentage = parsedExitFeePercentage
+		}
+
+		if txFeePercentage.Add(exitFeePercentage).GTE(sdk.NewDec(100)) {
+			return types.ErrFeesCannotBeOrExceed100Percent(types.DefaultCodespace).Result()
+		}
+
+		var noticeBatches uint64
+		if txFeePercentage.GT(bond.TxFeePercentage) || exitFeePercentage.GT(bond.ExitFeePercentage) {
+			noticeBatches = types.FeeIncreaseNoticeBatches
+		}
+
+		addEdit(types.AttributeKeyTxFeePercentage, bond.TxFeePercentage.String(),
+			txFeePercentage.String(), noticeBatches)
+		addEdit(types.AttributeKeyExitFeePercentage, bond.ExitFeePercentage.String(),
+			exitFeePercentage.String(), noticeBatches)
+
+		// Any previously pending change is replaced by this one
+		feesEdited = true
+		pendingFeeChangeFound = noticeBatches > 0
+		if pendingFeeChangeFound {
+			pendingFeeChange = types.NewPendingFeeChange(bond.BondDid,
+				txFeePercentage, exitFeePercentage, noticeBatches)
+		} else {
+			bond.TxFeePercentage = txFeePercentage
+			bond.ExitFeePercentage = exitFeePercentage
+		}
+	}
+
+	if msg.FeeAddress != types.DoNotModifyField {
+		feeAddress, err := sdk.AccAddressFromBech32(msg.FeeAddress)
+		if err != nil {
+			return sdk.ErrInvalidAddress(err.Error()).Result()
+		}
+		addEdit(types.AttributeKeyFeeAddress, bond.FeeAddress.String(), feeAddress.String(), 0)
+		bond.FeeAddress = feeAddress
+	}
+
+	if msg.MaxSupply != types.DoNotModifyField {
+		maxSupply, err := sdk.ParseCoin(msg.MaxSupply)
+		if err != nil {
+			return sdk.ErrInternal(err.Error()).Result()
+		} else if maxSupply.Denom != bond.Token {
+			return types.ErrMaxSupplyDenomDoesNotMatchTokenDenom(types.DefaultCodespace).Result()
+		}
+
+		// Max supply cannot be less than supply (including pending buys)
+		adjustedSupply := keeper.GetSupplyAdjustedForBuy(ctx, bond.BondDid)
+		if maxSupply.IsLT(adjustedSupply) {
+			return types.ErrMaxSupplyBelowSupply(types.DefaultCodespace, maxSupply, adjustedSupply).Result()
+		}
+		addEdit(types.AttributeKeyMaxSupply, bond.MaxSupply.String(), maxSupply.String(), 0)
+		bond.MaxSupply = maxSupply
+	}
+
+	// The current batch keeps its number of blocks remaining, and the new
+	// batch blocks are used from the next batch onwards
+	if msg.BatchBlocks != types.DoNotModifyField {
+		batchBlocks, err := sdk.ParseUint(msg.BatchBlocks)
+		if err != nil {
+			return types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "batch blocks").Result()
+		} else if batchBlocks.IsZero() {
+			return types.ErrArgumentMustBePositive(types.DefaultCodespace, "batch blocks").Result()
+		}
+		addEdit(types.AttributeKeyBatchBlocks, bond.BatchBlocks.String(), batchBlocks.String(), 1)
+		bond.BatchBlocks = batchBlocks
+	}
+
 	logger := keeper.Logger(ctx)
 	logger.Info(fmt.Sprintf("bond %s edited by %s",
 		msg.BondDid, msg.EditorDid))
 
 	keeper.SetBond(ctx, bond.BondDid, bond)
+	if feesEdited {
+		keeper.DeletePendingFeeChange(ctx, bond.BondDid)
+		if pendingFeeChangeFound {
+			keeper.SetPendingFeeChange(ctx, pendingFeeChange)
+		}
+	}
+	for _, edit := range edits {
+		keeper.AddBondEdit(ctx, edit)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -179,6 +308,11 @@ func handleMsgEditBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgEditB
 			sdk.NewAttribute(types.AttributeKeyOrderQuantityLimits, msg.OrderQuantityLimits),
 			sdk.NewAttribute(types.AttributeKeySanityRate, msg.SanityRate),
 			sdk.NewAttribute(types.AttributeKeySanityMarginPercentage, msg.SanityMarginPercentage),
+			sdk.NewAttribute(types.AttributeKeyTxFeePercentage, msg.TxFeePercentage),
+			sdk.NewAttribute(types.AttributeKeyExitFeePercentage, msg.ExitFeePercentage),
+			sdk.NewAttribute(types.AttributeKeyFeeAddress, msg.FeeAddress),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, msg.MaxSupply),
+			sdk.NewAttribute(types.AttributeKeyBatchBlocks, msg.BatchBlocks),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -191,7 +325,7 @@ func handleMsgEditBond(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgEditB
 }
 
 func handleMsgBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.Result {
-	buyerAddr := types.DidToAddr(msg.BuyerDid)
+	buyerAddr := ixo.DidToAddr(msg.BuyerDid)
 
 	bond, found := keeper.GetBond(ctx, msg.BondDid)
 	if !found {
@@ -216,7 +350,7 @@ func handleMsgBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.R
 	// For the swapper, the first buy is the initialisation of the reserves
 	// The max prices are used as the actual prices and one token is minted
 	// The amount of token serves to define the price of adding more liquidity
-	if bond.CurrentSupply.IsZero() && bond.FunctionType == types.SwapperFunction {
+	if bond.CurrentSupply.IsZero() && bond.IsSwapper() {
 		return performFirstSwapperFunctionBuy(ctx, keeper, msg)
 	}
 
@@ -260,7 +394,7 @@ func handleMsgBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.R
 }
 
 func performFirstSwapperFunctionBuy(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgBuy) sdk.Result {
-	buyerAddr := types.DidToAddr(msg.BuyerDid)
+	buyerAddr := ixo.DidToAddr(msg.BuyerDid)
 
 	// TODO: investigate effect that a high amount has on future buyers' ability to buy.
 
@@ -302,6 +436,13 @@ func performFirstSwapperFunctionBuy(ctx sdk.Context, keeper keeper.Keeper, msg t
 	// Update supply
 	keeper.SetCurrentSupply(ctx, bond.BondDid, bond.CurrentSupply.Add(msg.Amount))
 
+	// Record fulfilled order in order history, at the price per token set by
+	// the initial liquidity and without fees
+	prices := sdk.NewDecCoins(msg.MaxPrices).QuoDec(sdk.NewDecFromInt(msg.Amount.Amount))
+	keeper.AddOrderRecord(ctx, types.NewFulfilledBuyRecord(bond.BondDid,
+		types.NewBuyOrder(msg.BuyerDid, msg.Amount, msg.MaxPrices), prices,
+		msg.MaxPrices, sdk.Coins{}, sdk.Coins{}))
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeInitSwapper,
@@ -320,7 +461,7 @@ func performFirstSwapperFunctionBuy(ctx sdk.Context, keeper keeper.Keeper, msg t
 }
 
 func handleMsgSell(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSell) sdk.Result {
-	sellerAddr := types.DidToAddr(msg.SellerDid)
+	sellerAddr := ixo.DidToAddr(msg.SellerDid)
 
 	bond, found := keeper.GetBond(ctx, msg.BondDid)
 	if !found {
@@ -387,7 +528,7 @@ func handleMsgSell(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSell) sdk
 }
 
 func handleMsgSwap(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSwap) sdk.Result {
-	swapperAddr := types.DidToAddr(msg.SwapperDid)
+	swapperAddr := ixo.DidToAddr(msg.SwapperDid)
 
 	bond, found := keeper.GetBond(ctx, msg.BondDid)
 	if !found {
@@ -395,9 +536,8 @@ func handleMsgSwap(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgSwap) sdk
 	}
 
 	// Check that from and to use reserve token names
-	fromAndTo := sdk.NewCoins(msg.From, sdk.NewCoin(msg.ToToken, sdk.OneInt()))
 	fromAndToDenoms := msg.From.Denom + "," + msg.ToToken
-	if !bond.ReserveDenomsEqualTo(fromAndTo) {
+	if !bond.IsReserveToken(msg.From.Denom) || !bond.IsReserveToken(msg.ToToken) {
 		return types.ErrReserveDenomsMismatch(types.DefaultCodespace, fromAndToDenoms, bond.ReserveTokens).Result()
 	}
 
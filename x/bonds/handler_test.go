@@ -0,0 +1,240 @@
+package bonds
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+func requireInvariantsHold(t *testing.T, ctx sdk.Context, k keeper.Keeper) {
+	res, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, res)
+}
+
+func TestHandler_CreateBuySell(t *testing.T) {
+	ctx, k, _ := keeper.CreateTestInput()
+	handler := NewHandler(k)
+
+	buyerAddr := ixo.DidToAddr(types.ValidBuyerDid)
+	buyer := types.NewValidSovrinDid(types.ValidBuyerDid)
+	_, err := k.CoinKeeper.AddCoins(ctx, buyerAddr, sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestReserveToken, 100000)))
+	require.Nil(t, err)
+
+	// Create bond
+	createMsg := types.NewValidMsgCreateBond(types.PowerFunction,
+		types.ValidPowerFunctionParams, []string{types.TestReserveToken})
+	res := handler(ctx, createMsg)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, k.BondExists(ctx, createMsg.BondDid))
+
+	// Creating the same bond twice fails
+	res = handler(ctx, createMsg)
+	require.False(t, res.IsOK())
+
+	// Buy 10 tokens, at a price of 5000res
+	buyMsg := types.NewMsgBuy(buyer, sdk.NewInt64Coin(types.TestToken, 10),
+		sdk.NewCoins(sdk.NewInt64Coin(types.TestReserveToken, 6000)), createMsg.BondDid)
+	res = handler(ctx, buyMsg)
+	require.True(t, res.IsOK(), res.Log)
+	requireInvariantsHold(t, ctx, k)
+
+	EndBlocker(ctx, k)
+	requireInvariantsHold(t, ctx, k)
+
+	bond := k.MustGetBond(ctx, createMsg.BondDid)
+	require.Equal(t, sdk.NewInt64Coin(types.TestToken, 10), bond.CurrentSupply)
+
+	// Price point recorded for the batch
+	points := k.GetPricePoints(ctx, createMsg.BondDid, 0, 0)
+	require.Len(t, points, 1)
+	require.Equal(t, sdk.NewInt64Coin(types.TestToken, 10), points[0].BuyVolume)
+	require.Equal(t, sdk.NewInt64Coin(types.TestToken, 10), points[0].Supply)
+	require.Equal(t, "500.000000000000000000", points[0].BuyPrices.AmountOf(types.TestReserveToken).String())
+	require.Equal(t, int64(10), k.CoinKeeper.GetCoins(ctx, buyerAddr).AmountOf(types.TestToken).Int64())
+	require.Equal(t, int64(95000), k.CoinKeeper.GetCoins(ctx, buyerAddr).AmountOf(types.TestReserveToken).Int64())
+
+	// Sell 5 tokens, getting back 5000res-(12*125/3+500)res = 4000res
+	sellMsg := types.NewMsgSell(buyer, sdk.NewInt64Coin(types.TestToken, 5), createMsg.BondDid)
+	res = handler(ctx, sellMsg)
+	require.True(t, res.IsOK(), res.Log)
+	requireInvariantsHold(t, ctx, k)
+
+	EndBlocker(ctx, k)
+	requireInvariantsHold(t, ctx, k)
+
+	bond = k.MustGetBond(ctx, createMsg.BondDid)
+	require.Equal(t, sdk.NewInt64Coin(types.TestToken, 5), bond.CurrentSupply)
+	require.Equal(t, int64(99000), k.CoinKeeper.GetCoins(ctx, buyerAddr).AmountOf(types.TestReserveToken).Int64())
+
+	// Both orders recorded in the buyer's and the bond's order history
+	total, records := k.GetAccountOrderRecords(ctx, types.ValidBuyerDid, 1, 10)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, types.AttributeValueBuyOrder, records[0].OrderType)
+	require.Equal(t, types.OrderStatusFulfilled, records[0].Status)
+	require.Equal(t, int64(10), records[0].TokensMinted.Int64())
+	require.Equal(t, int64(5000), records[0].ChargedPrices.AmountOf(types.TestReserveToken).Int64())
+	require.Equal(t, int64(1000), records[0].ReturnedToAddress.AmountOf(types.TestReserveToken).Int64())
+	require.Equal(t, types.AttributeValueSellOrder, records[1].OrderType)
+	require.Equal(t, int64(5), records[1].TokensBurned.Int64())
+	require.Equal(t, int64(4000), records[1].ReturnedToAddress.AmountOf(types.TestReserveToken).Int64())
+
+	total, _ = k.GetBondOrderRecords(ctx, createMsg.BondDid, 1, 10)
+	require.Equal(t, uint64(2), total)
+}
+
+func TestHandler_Swap(t *testing.T) {
+	ctx, k, _ := keeper.CreateTestInput()
+	handler := NewHandler(k)
+
+	swapperAddr := ixo.DidToAddr(types.ValidBuyerDid)
+	swapper := types.NewValidSovrinDid(types.ValidBuyerDid)
+	_, err := k.CoinKeeper.AddCoins(ctx, swapperAddr, sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestReserveToken, 10000),
+		sdk.NewInt64Coin(types.TestReserveToken2, 10000)))
+	require.Nil(t, err)
+
+	reserveTokens := []string{types.TestReserveToken, types.TestReserveToken2}
+	for _, functionType := range []string{types.SwapperFunction, types.WeightedSwapperFunction} {
+		createMsg := types.NewValidMsgCreateBond(functionType,
+			types.ValidWeightedSwapperFunctionParams, reserveTokens)
+		createMsg.BondDid = createMsg.BondDid + functionType[:3]
+		createMsg.Token = createMsg.Token + functionType[:3]
+		if functionType == types.SwapperFunction {
+			createMsg.FunctionParameters = nil
+		}
+		res := handler(ctx, createMsg)
+		require.True(t, res.IsOK(), res.Log)
+
+		// First buy initialises the reserves
+		buyMsg := types.NewMsgBuy(swapper, sdk.NewInt64Coin(createMsg.Token, 1), sdk.NewCoins(
+			sdk.NewInt64Coin(types.TestReserveToken, 1000),
+			sdk.NewInt64Coin(types.TestReserveToken2, 1000)), createMsg.BondDid)
+		res = handler(ctx, buyMsg)
+		require.True(t, res.IsOK(), res.Log)
+		requireInvariantsHold(t, ctx, k)
+
+		// The first buy is recorded in the bond's order history
+		total, records := k.GetBondOrderRecords(ctx, createMsg.BondDid, 1, 10)
+		require.Equal(t, uint64(1), total)
+		require.Equal(t, types.AttributeValueBuyOrder, records[0].OrderType)
+		require.Equal(t, types.OrderStatusFulfilled, records[0].Status)
+		require.Equal(t, types.ValidBuyerDid, records[0].AccountDid)
+		require.Equal(t, int64(1), records[0].TokensMinted.Int64())
+		require.Equal(t, buyMsg.MaxPrices, records[0].ChargedPrices)
+		require.Equal(t, "1000.000000000000000000", records[0].ExecutedPrices.AmountOf(types.TestReserveToken).String())
+
+		swapMsg := types.NewMsgSwap(swapper, sdk.NewInt64Coin(types.TestReserveToken, 100),
+			types.TestReserveToken2, createMsg.BondDid)
+		res = handler(ctx, swapMsg)
+		require.True(t, res.IsOK(), res.Log)
+		EndBlocker(ctx, k)
+		requireInvariantsHold(t, ctx, k)
+
+		bond := k.MustGetBond(ctx, createMsg.BondDid)
+		reserve := k.CoinKeeper.GetCoins(ctx, bond.ReserveAddress)
+		require.Equal(t, int64(1100), reserve.AmountOf(types.TestReserveToken).Int64())
+		require.True(t, reserve.AmountOf(types.TestReserveToken2).LT(sdk.NewInt(1000)))
+
+		// Swapping a non-reserve token fails
+		swapMsg.ToToken = "xyz"
+		res = handler(ctx, swapMsg)
+		require.False(t, res.IsOK())
+	}
+}
+
+func TestHandler_EditBond(t *testing.T) {
+	ctx, k, _ := keeper.CreateTestInput()
+	handler := NewHandler(k)
+
+	buyerAddr := ixo.DidToAddr(types.ValidBuyerDid)
+	buyer := types.NewValidSovrinDid(types.ValidBuyerDid)
+	_, err := k.CoinKeeper.AddCoins(ctx, buyerAddr, sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestReserveToken, 100000)))
+	require.Nil(t, err)
+
+	createMsg := types.NewValidMsgCreateBond(types.PowerFunction,
+		types.ValidPowerFunctionParams, []string{types.TestReserveToken})
+	createMsg.TxFeePercentage = sdk.NewDec(1)
+	res := handler(ctx, createMsg)
+	require.True(t, res.IsOK(), res.Log)
+
+	// Only the creator can edit the bond
+	editMsg := types.NewValidMsgEditBond()
+	editMsg.Name = "new name"
+	editMsg.EditorDid = types.ValidBuyerDid
+	res = handler(ctx, editMsg)
+	require.False(t, res.IsOK())
+
+	// Fee decreases take effect immediately
+	editMsg = types.NewValidMsgEditBond()
+	editMsg.TxFeePercentage = "0.5"
+	res = handler(ctx, editMsg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), k.MustGetBond(ctx, createMsg.BondDid).TxFeePercentage)
+
+	// Fees cannot add up to 100%
+	editMsg = types.NewValidMsgEditBond()
+	editMsg.ExitFeePercentage = "99.5"
+	res = handler(ctx, editMsg)
+	require.False(t, res.IsOK())
+
+	// Fee increases take effect after the notice period
+	editMsg = types.NewValidMsgEditBond()
+	editMsg.ExitFeePercentage = "2"
+	res = handler(ctx, editMsg)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, k.MustGetBond(ctx, createMsg.BondDid).ExitFeePercentage.IsZero())
+	for i := uint64(1); i < types.FeeIncreaseNoticeBatches; i++ {
+		EndBlocker(ctx, k)
+	}
+	require.True(t, k.MustGetBond(ctx, createMsg.BondDid).ExitFeePercentage.IsZero())
+	EndBlocker(ctx, k)
+	require.Equal(t, sdk.NewDec(2), k.MustGetBond(ctx, createMsg.BondDid).ExitFeePercentage)
+	_, found := k.GetPendingFeeChange(ctx, createMsg.BondDid)
+	require.False(t, found)
+
+	// Max supply cannot be less than the supply including pending buys
+	buyMsg := types.NewMsgBuy(buyer, sdk.NewInt64Coin(types.TestToken, 10),
+		sdk.NewCoins(sdk.NewInt64Coin(types.TestReserveToken, 6000)), createMsg.BondDid)
+	res = handler(ctx, buyMsg)
+	require.True(t, res.IsOK(), res.Log)
+	editMsg = types.NewValidMsgEditBond()
+	editMsg.MaxSupply = sdk.NewInt64Coin(types.TestToken, 9).String()
+	res = handler(ctx, editMsg)
+	require.False(t, res.IsOK())
+	editMsg.MaxSupply = sdk.NewInt64Coin(types.TestToken, 10).String()
+	res = handler(ctx, editMsg)
+	require.True(t, res.IsOK(), res.Log)
+
+	// Batch blocks apply from the next batch
+	editMsg = types.NewValidMsgEditBond()
+	editMsg.BatchBlocks = "3"
+	editMsg.FeeAddress = buyerAddr.String()
+	res = handler(ctx, editMsg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewUint(1), k.MustGetBatch(ctx, createMsg.BondDid).BlocksRemaining)
+	EndBlocker(ctx, k)
+	require.Equal(t, sdk.NewUint(3), k.MustGetBatch(ctx, createMsg.BondDid).BlocksRemaining)
+	require.Equal(t, buyerAddr, k.MustGetBond(ctx, createMsg.BondDid).FeeAddress)
+	requireInvariantsHold(t, ctx, k)
+
+	// Every change is recorded in the edit history
+	edits := k.GetBondEdits(ctx, createMsg.BondDid)
+	require.Len(t, edits, 7)
+	require.Equal(t, uint64(7), k.GetBondEditCount(ctx, createMsg.BondDid))
+	require.Equal(t, types.AttributeKeyTxFeePercentage, edits[0].Field)
+	require.Equal(t, "1.000000000000000000", edits[0].OldValue)
+	require.Equal(t, "0.500000000000000000", edits[0].NewValue)
+	require.Equal(t, uint64(0), edits[0].NoticeBatches)
+	require.Equal(t, types.AttributeKeyExitFeePercentage, edits[3].Field)
+	require.Equal(t, types.FeeIncreaseNoticeBatches, edits[3].NoticeBatches)
+	require.Equal(t, types.AttributeKeyMaxSupply, edits[4].Field)
+	require.Equal(t, types.AttributeKeyFeeAddress, edits[5].Field)
+	require.Equal(t, types.AttributeKeyBatchBlocks, edits[6].Field)
+}
@@ -179,7 +179,7 @@ func (k Keeper) GetUpdatedBatchPricesAfterSell(ctx sdk.Context, bondDid ixo.Did,
 
 func (k Keeper) PerformBuyAtPrice(ctx sdk.Context, bondDid ixo.Did, bo types.BuyOrder, prices sdk.DecCoins) (err sdk.Error) {
 	bond := k.MustGetBond(ctx, bondDid)
-	buyerAddr := types.DidToAddr(bo.AccountDid)
+	buyerAddr := ixo.DidToAddr(bo.AccountDid)
 
 	// Mint bond tokens
 	err = k.SupplyKeeper.MintCoins(ctx, types.BondsMintBurnAccount,
@@ -234,6 +234,10 @@ func (k Keeper) PerformBuyAtPrice(ctx sdk.Context, bondDid ixo.Did, bo types.Buy
 	// Update supply (max supply exceeded check done during MsgBuy)
 	k.SetCurrentSupply(ctx, bondDid, bond.CurrentSupply.Add(bo.Amount))
 
+	// Record fulfilled order in order history
+	k.AddOrderRecord(ctx, types.NewFulfilledBuyRecord(bondDid, bo, prices,
+		reservePricesRounded, txFees, returnToBuyer))
+
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("performed buy order for %s from %s", bo.Amount.String(), bo.AccountDid))
 
@@ -257,7 +261,7 @@ func (k Keeper) PerformBuyAtPrice(ctx sdk.Context, bondDid ixo.Did, bo types.Buy
 
 func (k Keeper) PerformSellAtPrice(ctx sdk.Context, bondDid ixo.Did, so types.SellOrder, prices sdk.DecCoins) (err sdk.Error) {
 	bond := k.MustGetBond(ctx, bondDid)
-	sellerAddr := types.DidToAddr(so.AccountDid)
+	sellerAddr := ixo.DidToAddr(so.AccountDid)
 
 	reserveReturns := types.MultiplyDecCoinsByInt(prices, so.Amount.Amount)
 	reserveReturnsRounded := types.RoundReserveReturns(reserveReturns)
@@ -285,6 +289,10 @@ func (k Keeper) PerformSellAtPrice(ctx sdk.Context, bondDid ixo.Did, so types.Se
 	// Update supply (burn more than supply check done during MsgSell)
 	k.SetCurrentSupply(ctx, bondDid, bond.CurrentSupply.Sub(so.Amount))
 
+	// Record fulfilled order in order history
+	k.AddOrderRecord(ctx, types.NewFulfilledSellRecord(bondDid, so, prices,
+		totalFees, totalReturns))
+
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("performed sell order for %s from %s", so.Amount.String(), so.AccountDid))
 
@@ -325,7 +333,7 @@ func (k Keeper) PerformSwap(ctx sdk.Context, bondDid ixo.Did, so types.SwapOrder
 	}
 
 	// Give resultant tokens to swapper (reserveReturns should never be zero)
-	swapperAddr := types.DidToAddr(so.AccountDid)
+	swapperAddr := ixo.DidToAddr(so.AccountDid)
 	err = k.CoinKeeper.SendCoins(ctx, bond.ReserveAddress, swapperAddr, reserveReturns)
 	if err != nil {
 		return err, false
@@ -347,6 +355,10 @@ func (k Keeper) PerformSwap(ctx sdk.Context, bondDid ixo.Did, so types.SwapOrder
 		}
 	}
 
+	// Record fulfilled order in order history
+	k.AddOrderRecord(ctx, types.NewFulfilledSwapRecord(bondDid, so,
+		adjustedInput, txFee, reserveReturns))
+
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("performed swap order for %s to %s from %s",
 		so.Amount.String(), reserveReturns, so.AccountDid))
@@ -420,12 +432,16 @@ func (k Keeper) PerformSwapOrders(ctx sdk.Context, bondDid ixo.Did) {
 					logger.Debug(fmt.Sprintf("cancellation reason: %s", err.Error()))
 
 					// Return from amount to swapper
-					swapperAddr := types.DidToAddr(so.AccountDid)
+					swapperAddr := ixo.DidToAddr(so.AccountDid)
 					err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
 						types.BatchesIntermediaryAccount, swapperAddr, sdk.Coins{so.Amount})
 					if err != nil {
 						panic(err)
 					}
+
+					// Record cancelled order in order history
+					k.AddOrderRecord(ctx, types.NewCancelledSwapRecord(
+						bondDid, so, batch.Swaps[i].CancelReason))
 				} else {
 					// Panic here since all calculations should have been done
 					// correctly to prevent any errors during the swap
@@ -488,12 +504,16 @@ func (k Keeper) CancelUnfulfillableBuys(ctx sdk.Context, bondDid ixo.Did) (cance
 				))
 
 				// Return reserve to buyer
-				buyerAddr := types.DidToAddr(bo.AccountDid)
+				buyerAddr := ixo.DidToAddr(bo.AccountDid)
 				err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx,
 					types.BatchesIntermediaryAccount, buyerAddr, bo.MaxPrices)
 				if err != nil {
 					panic(err)
 				}
+
+				// Record cancelled order in order history
+				k.AddOrderRecord(ctx, types.NewCancelledBuyRecord(
+					bondDid, bo, batch.Buys[i].CancelReason))
 			}
 		}
 	}
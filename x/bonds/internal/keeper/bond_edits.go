@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+func (k Keeper) GetPendingFeeChange(ctx sdk.Context, bondDid ixo.Did) (change types.PendingFeeChange, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingFeeChangeKey(bondDid))
+	if bz == nil {
+		return types.PendingFeeChange{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &change)
+	return change, true
+}
+
+func (k Keeper) GetAllPendingFeeChanges(ctx sdk.Context) (changes []types.PendingFeeChange) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingFeeChangesKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.PendingFeeChange
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func (k Keeper) SetPendingFeeChange(ctx sdk.Context, change types.PendingFeeChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingFeeChangeKey(change.BondDid), k.cdc.MustMarshalBinaryBare(change))
+}
+
+func (k Keeper) DeletePendingFeeChange(ctx sdk.Context, bondDid ixo.Did) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingFeeChangeKey(bondDid))
+}
+
+// ProgressPendingFeeChange counts down the bond's pending fee change (if any)
+// by one batch and applies it to the bond once its notice period is over.
+// It should be called once the batch's orders have been performed.
+func (k Keeper) ProgressPendingFeeChange(ctx sdk.Context, bondDid ixo.Did) {
+	change, found := k.GetPendingFeeChange(ctx, bondDid)
+	if !found {
+		return
+	}
+
+	if change.BatchesRemaining > 1 {
+		change.BatchesRemaining -= 1
+		k.SetPendingFeeChange(ctx, change)
+		return
+	}
+
+	bond := k.MustGetBond(ctx, bondDid)
+	bond.TxFeePercentage = change.TxFeePercentage
+	bond.ExitFeePercentage = change.ExitFeePercentage
+	k.SetBond(ctx, bondDid, bond)
+	k.DeletePendingFeeChange(ctx, bondDid)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("applied fee change to bond %s", bondDid))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeChange,
+		sdk.NewAttribute(types.AttributeKeyBondDid, bondDid),
+		sdk.NewAttribute(types.AttributeKeyTxFeePercentage, change.TxFeePercentage.String()),
+		sdk.NewAttribute(types.AttributeKeyExitFeePercentage, change.ExitFeePercentage.String()),
+	))
+}
+
+func (k Keeper) GetAllBondEdits(ctx sdk.Context) (edits []types.BondEdit) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BondEditsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var edit types.BondEdit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &edit)
+		edits = append(edits, edit)
+	}
+	return edits
+}
+
+// GetBondEdits returns the edit history of a bond, oldest edit first
+func (k Keeper) GetBondEdits(ctx sdk.Context, bondDid ixo.Did) (edits []types.BondEdit) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBondEditsKeyPrefix(bondDid))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var edit types.BondEdit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &edit)
+		edits = append(edits, edit)
+	}
+	return edits
+}
+
+func (k Keeper) GetBondEditCount(ctx sdk.Context, bondDid ixo.Did) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBondEditCountKey(bondDid))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetBondEditCount(ctx sdk.Context, bondDid ixo.Did, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBondEditCountKey(bondDid), sdk.Uint64ToBigEndian(count))
+}
+
+// AddBondEdit appends the edit to the end of the bond's edit history
+func (k Keeper) AddBondEdit(ctx sdk.Context, edit types.BondEdit) {
+	store := ctx.KVStore(k.storeKey)
+	index := k.GetBondEditCount(ctx, edit.BondDid)
+	store.Set(types.GetBondEditKey(edit.BondDid, index), k.cdc.MustMarshalBinaryBare(edit))
+	k.SetBondEditCount(ctx, edit.BondDid, index+1)
+}
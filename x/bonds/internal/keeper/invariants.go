@@ -89,8 +89,8 @@ func ReserveInvariant(k Keeper) sdk.Invariant {
 			denom := bond.Token
 			did := bond.BondDid
 
-			if bond.FunctionType == types.SwapperFunction {
-				continue // Check does not apply to swapper function
+			if bond.IsSwapper() {
+				continue // Check does not apply to swapper functions
 			}
 
 			expectedReserve := bond.CurveIntegral(bond.CurrentSupply.Amount)
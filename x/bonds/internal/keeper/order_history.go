@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+func (k Keeper) GetOrderRecordCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OrderRecordCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetOrderRecordCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OrderRecordCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+func (k Keeper) GetOrderRecord(ctx sdk.Context, id uint64) (record types.OrderRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOrderRecordKey(id))
+	if bz == nil {
+		return types.OrderRecord{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, true
+}
+
+func (k Keeper) GetAllOrderRecords(ctx sdk.Context) (records []types.OrderRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OrderRecordsKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.OrderRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetOrderRecord stores the record and indexes it by account and by bond
+func (k Keeper) SetOrderRecord(ctx sdk.Context, record types.OrderRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOrderRecordKey(record.Id), k.cdc.MustMarshalBinaryBare(record))
+	store.Set(types.GetAccountOrderKey(record.AccountDid, record.Id), []byte{})
+	store.Set(types.GetBondOrderKey(record.BondDid, record.Id), []byte{})
+}
+
+// AddOrderRecord assigns the next ID and the current height to the record
+// and stores it
+func (k Keeper) AddOrderRecord(ctx sdk.Context, record types.OrderRecord) {
+	record.Id = k.GetOrderRecordCount(ctx)
+	record.Height = ctx.BlockHeight()
+	k.SetOrderRecord(ctx, record)
+	k.SetOrderRecordCount(ctx, record.Id+1)
+}
+
+// getOrderRecordsByIndex returns the total number of records under the index
+// prefix and the records in the requested page (starting at 1), sorted by ID
+func (k Keeper) getOrderRecordsByIndex(ctx sdk.Context, prefix []byte,
+	page, limit uint64) (total uint64, records []types.OrderRecord) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	start, end := ixo.PageBounds(page, limit)
+	for ; iterator.Valid(); iterator.Next() {
+		if total >= start && total < end {
+			id := binary.BigEndian.Uint64(iterator.Key()[len(prefix):])
+			record, found := k.GetOrderRecord(ctx, id)
+			if !found {
+				panic("order record index refers to a missing record")
+			}
+			records = append(records, record)
+		}
+		total += 1
+	}
+	return total, records
+}
+
+func (k Keeper) GetAccountOrderRecords(ctx sdk.Context, accountDid ixo.Did,
+	page, limit uint64) (total uint64, records []types.OrderRecord) {
+	return k.getOrderRecordsByIndex(ctx,
+		types.GetAccountOrdersKeyPrefix(accountDid), page, limit)
+}
+
+func (k Keeper) GetBondOrderRecords(ctx sdk.Context, bondDid ixo.Did,
+	page, limit uint64) (total uint64, records []types.OrderRecord) {
+	return k.getOrderRecordsByIndex(ctx,
+		types.GetBondOrdersKeyPrefix(bondDid), page, limit)
+}
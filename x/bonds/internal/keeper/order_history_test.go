@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+)
+
+func TestOrderRecords(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	bondDid := types.ValidBondDid.Did
+	amount := sdk.NewInt64Coin(types.TestToken, 10)
+	maxPrices := sdk.NewCoins(sdk.NewInt64Coin(types.TestReserveToken, 100))
+	for i := 0; i < 5; i++ {
+		bo := types.NewBuyOrder(types.ValidBuyerDid, amount, maxPrices)
+		k.AddOrderRecord(ctx, types.NewCancelledBuyRecord(bondDid, bo, "reason"))
+
+		so := types.NewSellOrder(types.ValidSellerDid, amount)
+		k.AddOrderRecord(ctx, types.NewFulfilledSellRecord(bondDid, so, nil, nil, nil))
+	}
+	require.Equal(t, uint64(10), k.GetOrderRecordCount(ctx))
+	require.Len(t, k.GetAllOrderRecords(ctx), 10)
+
+	total, records := k.GetAccountOrderRecords(ctx, types.ValidBuyerDid, 2, 2)
+	require.Equal(t, uint64(5), total)
+	require.Len(t, records, 2)
+	require.Equal(t, uint64(4), records[0].Id)
+	require.Equal(t, uint64(6), records[1].Id)
+	require.Equal(t, types.OrderStatusCancelled, records[0].Status)
+	require.Equal(t, "reason", records[0].CancelReason)
+	require.Equal(t, maxPrices, records[0].ReturnedToAddress)
+
+	total, records = k.GetAccountOrderRecords(ctx, types.ValidSellerDid, 3, 2)
+	require.Equal(t, uint64(5), total)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(9), records[0].Id)
+	require.Equal(t, amount.Amount, records[0].TokensBurned)
+
+	total, records = k.GetBondOrderRecords(ctx, bondDid, 1, 100)
+	require.Equal(t, uint64(10), total)
+	require.Len(t, records, 10)
+
+	// Pages past the last index are empty rather than wrapping around
+	total, records = k.GetBondOrderRecords(ctx, bondDid, 1<<63, 2)
+	require.Equal(t, uint64(10), total)
+	require.Len(t, records, 0)
+
+	total, records = k.GetBondOrderRecords(ctx, bondDid+"2", 1, 100)
+	require.Equal(t, uint64(0), total)
+	require.Len(t, records, 0)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+func (k Keeper) GetAllPricePointsIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.PricePointsKeyPrefix)
+}
+
+func (k Keeper) GetAllPricePoints(ctx sdk.Context) (points []types.PricePoint) {
+	iterator := k.GetAllPricePointsIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var point types.PricePoint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &point)
+		points = append(points, point)
+	}
+	return points
+}
+
+func (k Keeper) SetPricePoint(ctx sdk.Context, pricePoint types.PricePoint) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPricePointKey(pricePoint.BondDid, pricePoint.Height)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(pricePoint))
+}
+
+// GetPricePoints returns the price points of a bond recorded between
+// fromHeight and toHeight (both inclusive), sorted by height
+func (k Keeper) GetPricePoints(ctx sdk.Context, bondDid ixo.Did, fromHeight, toHeight int64) (points []types.PricePoint) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.GetPricePointKey(bondDid, fromHeight)
+	end := types.GetPricePointKey(bondDid, toHeight+1)
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var point types.PricePoint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &point)
+		points = append(points, point)
+	}
+	return points
+}
+
+// PrunePricePoints deletes a bond's price points recorded before the height
+func (k Keeper) PrunePricePoints(ctx sdk.Context, bondDid ixo.Did, beforeHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.GetPricePointsKeyPrefix(bondDid)
+	end := types.GetPricePointKey(bondDid, beforeHeight)
+
+	var keys [][]byte
+	iterator := store.Iterator(start, end)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordPricePoint stores a snapshot of the bond after the batch's orders
+// have been performed. Nothing is recorded if the batch had no orders.
+func (k Keeper) RecordPricePoint(ctx sdk.Context, bondDid ixo.Did, batch types.Batch) {
+	bond := k.MustGetBond(ctx, bondDid)
+
+	buyVolume := sdk.NewCoin(bond.Token, sdk.ZeroInt())
+	for _, bo := range batch.Buys {
+		if !bo.IsCancelled() {
+			buyVolume = buyVolume.Add(bo.Amount)
+		}
+	}
+	sellVolume := sdk.NewCoin(bond.Token, sdk.ZeroInt())
+	for _, so := range batch.Sells {
+		if !so.IsCancelled() {
+			sellVolume = sellVolume.Add(so.Amount)
+		}
+	}
+	swapVolume := sdk.Coins{}
+	for _, so := range batch.Swaps {
+		if !so.IsCancelled() {
+			swapVolume = swapVolume.Add(sdk.Coins{so.Amount})
+		}
+	}
+
+	if buyVolume.IsZero() && sellVolume.IsZero() && swapVolume.IsZero() {
+		return
+	}
+
+	// The batch's buy and sell prices are only set if it had buys or sells,
+	// otherwise the current prices (after any swaps) are used instead
+	reserveBalances := k.GetReserveBalances(ctx, bondDid)
+	buyPrices, sellPrices := batch.BuyPrices, batch.SellPrices
+	if buyPrices.Empty() || sellPrices.Empty() {
+		currentPrices, err := bond.GetCurrentPricesPT(reserveBalances)
+		if err == nil {
+			buyPrices, sellPrices = currentPrices, currentPrices
+		}
+	}
+
+	height := ctx.BlockHeight()
+	k.SetPricePoint(ctx, types.NewPricePoint(bondDid, height,
+		ctx.BlockHeader().Time, buyPrices, sellPrices, bond.CurrentSupply,
+		reserveBalances, buyVolume, sellVolume, swapVolume))
+
+	if height > types.PricePointsMaxAge {
+		k.PrunePricePoints(ctx, bondDid, height-types.PricePointsMaxAge)
+	}
+}
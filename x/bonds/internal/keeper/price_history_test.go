@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+)
+
+func TestPricePoints(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+
+	bondDids := []string{types.ValidBondDid.Did, types.ValidBondDid.Did + "2"}
+	for _, bondDid := range bondDids {
+		for height := int64(1); height <= 10; height++ {
+			k.SetPricePoint(ctx, types.NewPricePoint(bondDid, height, time.Time{},
+				nil, nil, sdk.NewInt64Coin(types.TestToken, height), nil,
+				sdk.NewInt64Coin(types.TestToken, 0), sdk.NewInt64Coin(types.TestToken, 0), nil))
+		}
+	}
+
+	points := k.GetPricePoints(ctx, bondDids[0], 3, 5)
+	require.Len(t, points, 3)
+	for i, p := range points {
+		require.Equal(t, bondDids[0], p.BondDid)
+		require.Equal(t, int64(3+i), p.Height)
+	}
+
+	k.PrunePricePoints(ctx, bondDids[0], 8)
+	require.Len(t, k.GetPricePoints(ctx, bondDids[0], 0, 100), 3)
+	require.Len(t, k.GetPricePoints(ctx, bondDids[1], 0, 100), 10)
+	require.Len(t, k.GetAllPricePoints(ctx), 13)
+}
@@ -6,21 +6,29 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ixofoundation/ixo-cosmos/x/bonds/client"
 	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"strconv"
 	"strings"
 )
 
 const (
-	QueryBonds          = "bonds"
-	QueryBond           = "bond"
-	QueryBatch          = "batch"
-	QueryLastBatch      = "last_batch"
-	QueryCurrentPrice   = "current_price"
-	QueryCurrentReserve = "current_reserve"
-	QueryCustomPrice    = "custom_price"
-	QueryBuyPrice       = "buy_price"
-	QuerySellReturn     = "sell_return"
-	QuerySwapReturn     = "swap_return"
+	QueryBonds            = "bonds"
+	QueryBond             = "bond"
+	QueryBatch            = "batch"
+	QueryLastBatch        = "last_batch"
+	QueryCurrentPrice     = "current_price"
+	QueryCurrentReserve   = "current_reserve"
+	QueryCustomPrice      = "custom_price"
+	QueryBuyPrice         = "buy_price"
+	QuerySellReturn       = "sell_return"
+	QuerySwapReturn       = "swap_return"
+	QueryPriceHistory     = "price_history"
+	QueryCandles          = "candles"
+	QueryAccountOrders    = "account_orders"
+	QueryBondOrders       = "bond_orders"
+	QueryEditHistory      = "edit_history"
+	QueryPendingFeeChange = "pending_fee_change"
 )
 
 // NewQuerier is the module level router for state queries
@@ -47,6 +55,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return querySellReturn(ctx, path[1:], keeper)
 		case QuerySwapReturn:
 			return querySwapReturn(ctx, path[1:], keeper)
+		case QueryPriceHistory:
+			return queryPriceHistory(ctx, path[1:], keeper)
+		case QueryCandles:
+			return queryCandles(ctx, path[1:], keeper)
+		case QueryAccountOrders:
+			return queryAccountOrders(ctx, path[1:], keeper)
+		case QueryBondOrders:
+			return queryBondOrders(ctx, path[1:], keeper)
+		case QueryEditHistory:
+			return queryEditHistory(ctx, path[1:], keeper)
+		case QueryPendingFeeChange:
+			return queryPendingFeeChange(ctx, path[1:], keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown bonds query endpoint")
 		}
@@ -312,3 +332,185 @@ func querySwapReturn(ctx sdk.Context, path []string, keeper Keeper) (res []byte,
 
 	return bz, nil
 }
+
+func parseHeightRange(fromHeightStr, toHeightStr string) (fromHeight, toHeight int64, err sdk.Error) {
+	fromHeight, err2 := strconv.ParseInt(fromHeightStr, 10, 64)
+	if err2 != nil || fromHeight < 0 {
+		return 0, 0, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "from height")
+	}
+	toHeight, err2 = strconv.ParseInt(toHeightStr, 10, 64)
+	if err2 != nil || toHeight < 0 {
+		return 0, 0, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "to height")
+	}
+	if toHeight < fromHeight {
+		return 0, 0, types.ErrInvalidHeightRange(types.DefaultCodespace, fromHeight, toHeight)
+	}
+	return fromHeight, toHeight, nil
+}
+
+func queryPriceHistory(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondDid := path[0]
+
+	if !keeper.BondExists(ctx, bondDid) {
+		return nil, types.ErrBondDoesNotExist(types.DefaultCodespace, bondDid)
+	}
+
+	fromHeight, toHeight, err := parseHeightRange(path[1], path[2])
+	if err != nil {
+		return nil, err
+	}
+
+	pricePoints := keeper.GetPricePoints(ctx, bondDid, fromHeight, toHeight)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, pricePoints)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryCandles(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondDid := path[0]
+	reserveToken := path[1]
+
+	bond, found := keeper.GetBond(ctx, bondDid)
+	if !found {
+		return nil, types.ErrBondDoesNotExist(types.DefaultCodespace, bondDid)
+	} else if !bond.IsReserveToken(reserveToken) {
+		return nil, types.ErrTokenIsNotAValidReserveToken(types.DefaultCodespace, reserveToken)
+	}
+
+	fromHeight, toHeight, err := parseHeightRange(path[2], path[3])
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err2 := strconv.ParseInt(path[4], 10, 64)
+	if err2 != nil {
+		return nil, types.ErrArgumentMissingOrNonInteger(types.DefaultCodespace, "interval")
+	} else if interval <= 0 {
+		return nil, types.ErrArgumentMustBePositive(types.DefaultCodespace, "interval")
+	} else if (toHeight-fromHeight)/interval+1 > types.MaxCandlesPerQuery {
+		return nil, types.ErrTooManyCandles(types.DefaultCodespace, types.MaxCandlesPerQuery)
+	}
+
+	pricePoints := keeper.GetPricePoints(ctx, bondDid, fromHeight, toHeight)
+	candles := types.GetCandles(pricePoints, reserveToken, fromHeight, toHeight, interval)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, candles)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func parsePagination(pageStr, limitStr string) (page, limit uint64, err sdk.Error) {
+	page, err2 := strconv.ParseUint(pageStr, 10, 64)
+	if err2 != nil {
+		return 0, 0, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "page")
+	} else if page == 0 {
+		return 0, 0, types.ErrArgumentMustBePositive(types.DefaultCodespace, "page")
+	}
+	limit, err2 = strconv.ParseUint(limitStr, 10, 64)
+	if err2 != nil {
+		return 0, 0, types.ErrArgumentMissingOrNonUInteger(types.DefaultCodespace, "limit")
+	} else if limit == 0 {
+		return 0, 0, types.ErrArgumentMustBePositive(types.DefaultCodespace, "limit")
+	} else if limit > types.MaxOrderRecordsPerPage {
+		return 0, 0, types.ErrTooManyOrderRecords(types.DefaultCodespace, types.MaxOrderRecordsPerPage)
+	} else if !ixo.ValidPage(page, limit) {
+		return 0, 0, types.ErrPageTooLarge(types.DefaultCodespace, ixo.MaxPage(limit))
+	}
+	return page, limit, nil
+}
+
+func queryAccountOrders(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	accountDid := path[0]
+
+	page, limit, err := parsePagination(path[1], path[2])
+	if err != nil {
+		return nil, err
+	}
+
+	total, records := keeper.GetAccountOrderRecords(ctx, accountDid, page, limit)
+	result := types.QueryOrderHistory{
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+		Orders: records,
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryBondOrders(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondDid := path[0]
+
+	if !keeper.BondExists(ctx, bondDid) {
+		return nil, types.ErrBondDoesNotExist(types.DefaultCodespace, bondDid)
+	}
+
+	page, limit, err := parsePagination(path[1], path[2])
+	if err != nil {
+		return nil, err
+	}
+
+	total, records := keeper.GetBondOrderRecords(ctx, bondDid, page, limit)
+	result := types.QueryOrderHistory{
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+		Orders: records,
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryEditHistory(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondDid := path[0]
+
+	if !keeper.BondExists(ctx, bondDid) {
+		return nil, types.ErrBondDoesNotExist(types.DefaultCodespace, bondDid)
+	}
+
+	edits := keeper.GetBondEdits(ctx, bondDid)
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, edits)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryPendingFeeChange(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	bondDid := path[0]
+
+	if !keeper.BondExists(ctx, bondDid) {
+		return nil, types.ErrBondDoesNotExist(types.DefaultCodespace, bondDid)
+	}
+
+	change, found := keeper.GetPendingFeeChange(ctx, bondDid)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("pending fee change for '%s' does not exist", bondDid))
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, change)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	cParams "github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/types"
+)
+
+func CreateTestInput() (sdk.Context, Keeper, *codec.Codec) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	actStoreKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyStoreKey := sdk.NewKVStoreKey(supply.StoreKey)
+	stakingStoreKey := sdk.NewKVStoreKey(staking.StoreKey)
+	stakingTStoreKey := sdk.NewTransientStoreKey(staking.TStoreKey)
+	keyParams := sdk.NewKVStoreKey(cParams.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(cParams.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(actStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(supplyStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(stakingStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(stakingTStoreKey, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	_ = ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abciTypes.Header{}, false, log.NewNopLogger())
+	cdc := MakeTestCodec()
+
+	maccPerms := map[string][]string{
+		staking.BondedPoolName:           {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:        {supply.Burner, supply.Staking},
+		types.BondsMintBurnAccount:       {supply.Minter, supply.Burner},
+		types.BatchesIntermediaryAccount: nil,
+	}
+
+	pk := cParams.NewKeeper(cdc, keyParams, tkeyParams, cParams.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(
+		cdc, actStoreKey, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount,
+	)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
+	supplyKeeper := supply.NewKeeper(cdc, supplyStoreKey, accountKeeper, bankKeeper, maccPerms)
+	stakingKeeper := staking.NewKeeper(cdc, stakingStoreKey, stakingTStoreKey,
+		supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	stakingKeeper.SetParams(ctx, staking.DefaultParams())
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{}))
+
+	keeper := NewKeeper(bankKeeper, supplyKeeper, accountKeeper, stakingKeeper, storeKey, cdc)
+
+	return ctx, keeper, cdc
+}
+
+func MakeTestCodec() *codec.Codec {
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	return cdc
+}
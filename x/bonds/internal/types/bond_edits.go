@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+const (
+	// Number of batches that need to end before a fee increase takes effect.
+	// Orders in these batches are still performed at the previous fees.
+	FeeIncreaseNoticeBatches uint64 = 10
+)
+
+// PendingFeeChange is a fee increase waiting for its notice period to end
+type PendingFeeChange struct {
+	BondDid           ixo.Did `json:"bond_did" yaml:"bond_did"`
+	TxFeePercentage   sdk.Dec `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
+	ExitFeePercentage sdk.Dec `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
+	BatchesRemaining  uint64  `json:"batches_remaining" yaml:"batches_remaining"`
+}
+
+func NewPendingFeeChange(bondDid ixo.Did, txFeePercentage,
+	exitFeePercentage sdk.Dec, batchesRemaining uint64) PendingFeeChange {
+	return PendingFeeChange{
+		BondDid:           bondDid,
+		TxFeePercentage:   txFeePercentage,
+		ExitFeePercentage: exitFeePercentage,
+		BatchesRemaining:  batchesRemaining,
+	}
+}
+
+// BondEdit is an entry in a bond's edit history. NoticeBatches is the number
+// of batches after which the change takes effect (zero if immediate).
+type BondEdit struct {
+	BondDid       ixo.Did `json:"bond_did" yaml:"bond_did"`
+	EditorDid     ixo.Did `json:"editor_did" yaml:"editor_did"`
+	Height        int64   `json:"height" yaml:"height"`
+	Field         string  `json:"field" yaml:"field"`
+	OldValue      string  `json:"old_value" yaml:"old_value"`
+	NewValue      string  `json:"new_value" yaml:"new_value"`
+	NoticeBatches uint64  `json:"notice_batches" yaml:"notice_batches"`
+}
+
+func NewBondEdit(bondDid, editorDid ixo.Did, height int64, field, oldValue,
+	newValue string, noticeBatches uint64) BondEdit {
+	return BondEdit{
+		BondDid:       bondDid,
+		EditorDid:     editorDid,
+		Height:        height,
+		Field:         field,
+		OldValue:      oldValue,
+		NewValue:      newValue,
+		NoticeBatches: noticeBatches,
+	}
+}
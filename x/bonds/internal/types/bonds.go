@@ -8,12 +8,19 @@ import (
 )
 
 const (
-	PowerFunction    = "power_function"
-	SigmoidFunction  = "sigmoid_function"
-	SwapperFunction  = "swapper_function"
-	DoNotModifyField = "[do-not-modify]"
+	PowerFunction           = "power_function"
+	SigmoidFunction         = "sigmoid_function"
+	SwapperFunction         = "swapper_function"
+	WeightedSwapperFunction = "weighted_swapper_function"
+	DoNotModifyField        = "[do-not-modify]"
 
 	AnyNumberOfReserveTokens = -1
+
+	MinNoOfReserveTokensForWeightedSwapper = 2
+
+	// Weights are used as the exponent of a power in swaps, so they are kept
+	// small enough for the power to be calculated
+	MaxReserveWeight = 1000000
 )
 
 var (
@@ -21,12 +28,16 @@ var (
 		PowerFunction:   {"m", "n", "c"},
 		SigmoidFunction: {"a", "b", "c"},
 		SwapperFunction: nil,
+		// Weighted swapper parameters are one weight per reserve token, with
+		// the reserve token denomination as the parameter (e.g. res:1,rez:2)
+		WeightedSwapperFunction: nil,
 	}
 
 	NoOfReserveTokensForFunctionType = map[string]int{
-		PowerFunction:   AnyNumberOfReserveTokens,
-		SigmoidFunction: AnyNumberOfReserveTokens,
-		SwapperFunction: 2,
+		PowerFunction:           AnyNumberOfReserveTokens,
+		SigmoidFunction:         AnyNumberOfReserveTokens,
+		SwapperFunction:         2,
+		WeightedSwapperFunction: AnyNumberOfReserveTokens,
 	}
 )
 
@@ -64,6 +75,29 @@ func (fps FunctionParams) AsMap() (paramsMap map[string]sdk.Int) {
 	return paramsMap
 }
 
+func CheckReserveWeights(weights FunctionParams, reserveTokens []string) sdk.Error {
+	if len(reserveTokens) < MinNoOfReserveTokensForWeightedSwapper {
+		return ErrTooFewReserveTokens(DefaultCodespace, MinNoOfReserveTokensForWeightedSwapper)
+	} else if len(weights) != len(reserveTokens) {
+		return ErrIncorrectNumberOfFunctionParameters(DefaultCodespace, len(reserveTokens))
+	}
+
+	// Check that each reserve token has a positive weight
+	weightsMap := weights.AsMap()
+	for _, r := range reserveTokens {
+		w, ok := weightsMap[r]
+		if !ok {
+			return ErrReserveTokenWeightMissing(DefaultCodespace, r)
+		} else if !w.IsPositive() {
+			return ErrArgumentMustBePositive(DefaultCodespace, "FunctionParams:"+r)
+		} else if w.GT(sdk.NewInt(MaxReserveWeight)) {
+			return ErrReserveTokenWeightTooLarge(DefaultCodespace, r, MaxReserveWeight)
+		}
+	}
+
+	return nil
+}
+
 type Bond struct {
 	Token                  string         `json:"token" yaml:"token"`
 	Name                   string         `json:"name" yaml:"name"`
@@ -123,6 +157,20 @@ func NewBond(token, name, description string, creatorDid ixo.Did,
 	}
 }
 
+func (bond Bond) IsSwapper() bool {
+	return bond.FunctionType == SwapperFunction ||
+		bond.FunctionType == WeightedSwapperFunction
+}
+
+func (bond Bond) IsReserveToken(denom string) bool {
+	for _, r := range bond.ReserveTokens {
+		if r == denom {
+			return true
+		}
+	}
+	return false
+}
+
 //noinspection GoNilness
 func (bond Bond) GetNewReserveDecCoins(amount sdk.Dec) (coins sdk.DecCoins) {
 	for _, r := range bond.ReserveTokens {
@@ -159,6 +207,8 @@ func (bond Bond) GetPricesAtSupply(supply sdk.Int) (result sdk.DecCoins, err sdk
 		temp3 := SquareRootInt(temp2)
 		result = bond.GetNewReserveDecCoins(aDec.Mul(sdk.NewDecFromInt(temp1).Quo(temp3).Add(sdk.OneDec())))
 	case SwapperFunction:
+		fallthrough
+	case WeightedSwapperFunction:
 		return nil, ErrFunctionNotAvailableForFunctionType(DefaultCodespace)
 	default:
 		panic("unrecognized function type")
@@ -179,6 +229,8 @@ func (bond Bond) GetCurrentPricesPT(reserveBalances sdk.Coins) (sdk.DecCoins, sd
 	case SigmoidFunction:
 		return bond.GetPricesAtSupply(bond.CurrentSupply.Amount)
 	case SwapperFunction:
+		fallthrough
+	case WeightedSwapperFunction:
 		return bond.GetPricesToMint(sdk.OneInt(), reserveBalances)
 	default:
 		panic("unrecognized function type")
@@ -217,6 +269,8 @@ func (bond Bond) CurveIntegral(supply sdk.Int) (result sdk.Dec) {
 		constant := aDec.Mul(SquareRootDec(bDec.Mul(bDec).Add(cDec)))
 		result = temp5.Sub(constant)
 	case SwapperFunction:
+		fallthrough
+	case WeightedSwapperFunction:
 		panic("invalid function for function type")
 	default:
 		panic("unrecognized function type")
@@ -242,21 +296,23 @@ func (bond Bond) GetReserveDeltaForLiquidityDelta(mintOrBurn sdk.Int, reserveBal
 	case SigmoidFunction:
 		panic("invalid function for function type")
 	case SwapperFunction:
-		resToken1 := bond.ReserveTokens[0]
-		resToken2 := bond.ReserveTokens[1]
-		resBalance1 := sdk.NewDecFromInt(reserveBalances.AmountOf(resToken1))
-		resBalance2 := sdk.NewDecFromInt(reserveBalances.AmountOf(resToken2))
+		fallthrough
+	case WeightedSwapperFunction:
 		mintOrBurnDec := sdk.NewDecFromInt(mintOrBurn)
 
 		// Using Uniswap formulae: x' = (1+-α)x = x +- Δx, where α = Δx/x
-		// Where x is any of the two reserve balances or the current supply
+		// Where x is any of the reserve balances or the current supply
 		// and x' is any of the updated reserve balances or the updated supply
 		// By making Δx subject of the formula: Δx = αx
+		// Note: this also holds for weighted swappers since adding/removing
+		// liquidity proportionally to all balances does not change prices
 		alpha := mintOrBurnDec.Quo(sdk.NewDecFromInt(bond.CurrentSupply.Amount))
 
-		result := sdk.DecCoins{
-			sdk.NewDecCoinFromDec(resToken1, alpha.Mul(resBalance1)),
-			sdk.NewDecCoinFromDec(resToken2, alpha.Mul(resBalance2)),
+		// Reserve tokens are sorted, so the result is also sorted
+		var result sdk.DecCoins
+		for _, r := range bond.ReserveTokens {
+			resBalance := sdk.NewDecFromInt(reserveBalances.AmountOf(r))
+			result = append(result, sdk.NewDecCoinFromDec(r, alpha.Mul(resBalance)))
 		}
 		if result.IsAnyNegative() {
 			panic(fmt.Sprintf("negative reserve delta result for bond %s", bond))
@@ -296,6 +352,8 @@ func (bond Bond) GetPricesToMint(mint sdk.Int, reserveBalances sdk.Coins) (sdk.D
 		}
 		return bond.GetNewReserveDecCoins(priceToMint), nil
 	case SwapperFunction:
+		fallthrough
+	case WeightedSwapperFunction:
 		if bond.CurrentSupply.Amount.IsZero() {
 			return nil, ErrFunctionRequiresNonZeroCurrentSupply(DefaultCodespace)
 		}
@@ -330,6 +388,8 @@ func (bond Bond) GetReturnsForBurn(burn sdk.Int, reserveBalances sdk.Coins) sdk.
 		// TODO: investigate possibility of negative returnForBurn
 		return bond.GetNewReserveDecCoins(returnForBurn)
 	case SwapperFunction:
+		fallthrough
+	case WeightedSwapperFunction:
 		return bond.GetReserveDeltaForLiquidityDelta(burn, reserveBalances)
 	default:
 		panic("unrecognized function type")
@@ -350,10 +410,12 @@ func (bond Bond) GetReturnsForSwap(from sdk.Coin, toToken string, reserveBalance
 	case SigmoidFunction:
 		return nil, sdk.Coin{}, ErrFunctionNotAvailableForFunctionType(DefaultCodespace)
 	case SwapperFunction:
+		fallthrough
+	case WeightedSwapperFunction:
 		// Check that from and to are reserve tokens
-		if from.Denom != bond.ReserveTokens[0] && from.Denom != bond.ReserveTokens[1] {
+		if !bond.IsReserveToken(from.Denom) {
 			return nil, sdk.Coin{}, ErrTokenIsNotAValidReserveToken(DefaultCodespace, from.Denom)
-		} else if toToken != bond.ReserveTokens[0] && toToken != bond.ReserveTokens[1] {
+		} else if !bond.IsReserveToken(toToken) {
 			return nil, sdk.Coin{}, ErrTokenIsNotAValidReserveToken(DefaultCodespace, toToken)
 		}
 
@@ -370,8 +432,17 @@ func (bond Bond) GetReturnsForSwap(from sdk.Coin, toToken string, reserveBalance
 			return nil, sdk.Coin{}, ErrSwapAmountTooSmallToGiveAnyReturn(DefaultCodespace, from.Denom, toToken)
 		}
 
-		// Calculate output amount using Uniswap formula: Δy = (Δx*y)/(x+Δx)
-		outAmt := inAmt.Mul(outRes).Quo(inRes.Add(inAmt))
+		// Calculate output amount
+		var outAmt sdk.Int
+		if bond.FunctionType == SwapperFunction {
+			// Using Uniswap formula: Δy = (Δx*y)/(x+Δx)
+			outAmt = inAmt.Mul(outRes).Quo(inRes.Add(inAmt))
+		} else {
+			outAmt, err = bond.getWeightedSwapReturn(inAmt, inRes, outRes, from.Denom, toToken)
+			if err != nil {
+				return nil, sdk.Coin{}, err
+			}
+		}
 
 		// Check that not giving out all of the available outRes or nothing at all
 		if outAmt.Equal(outRes) {
@@ -388,6 +459,37 @@ func (bond Bond) GetReturnsForSwap(from sdk.Coin, toToken string, reserveBalance
 	}
 }
 
+func (bond Bond) getWeightedSwapReturn(inAmt, inRes, outRes sdk.Int, fromToken, toToken string) (sdk.Int, sdk.Error) {
+	weights := bond.FunctionParameters.AsMap()
+	inWeight := weights[fromToken]
+	outWeight := weights[toToken]
+
+	// Using Balancer formula: Δy = y*(1-(x/(x+Δx))^(wx/wy))
+	inResDec := sdk.NewDecFromInt(inRes)
+	ratio := inResDec.Quo(inResDec.Add(sdk.NewDecFromInt(inAmt)))
+	factor, err := ApproxPowerDec(ratio, inWeight, outWeight)
+	if err != nil {
+		return sdk.Int{}, ErrInvalidFunctionParameter(DefaultCodespace, err.Error())
+	}
+
+	// Output is rounded down so that the swapper gets less in return
+	return sdk.NewDecFromInt(outRes).Mul(sdk.OneDec().Sub(factor)).TruncateInt(), nil
+}
+
+func (bond Bond) GetExchangeRate(reserves sdk.Coins, token1, token2 string) sdk.Dec {
+	resBalance1 := sdk.NewDecFromInt(reserves.AmountOf(token1))
+	resBalance2 := sdk.NewDecFromInt(reserves.AmountOf(token2))
+	if bond.FunctionType != WeightedSwapperFunction {
+		return resBalance1.Quo(resBalance2)
+	}
+
+	// Weighted spot price: (x/wx)/(y/wy)
+	weights := bond.FunctionParameters.AsMap()
+	weight1 := sdk.NewDecFromInt(weights[token1])
+	weight2 := sdk.NewDecFromInt(weights[token2])
+	return resBalance1.Quo(weight1).Quo(resBalance2.Quo(weight2))
+}
+
 func (bond Bond) GetTxFee(reserveAmount sdk.DecCoin) sdk.Coin {
 	feeAmount := bond.TxFeePercentage.QuoInt64(100).Mul(reserveAmount.Amount)
 	return RoundFee(sdk.NewDecCoinFromDec(reserveAmount.Denom, feeAmount))
@@ -438,12 +540,11 @@ func (bond Bond) ReservesViolateSanityRate(newReserves sdk.Coins) bool {
 		return false
 	}
 
-	// Get new rate from new balances
+	// Get new rate from new balances (for weighted swappers with more than
+	// two reserve tokens, the rate between the first two tokens is used)
 	resToken1 := bond.ReserveTokens[0]
 	resToken2 := bond.ReserveTokens[1]
-	resBalance1 := sdk.NewDecFromInt(newReserves.AmountOf(resToken1))
-	resBalance2 := sdk.NewDecFromInt(newReserves.AmountOf(resToken2))
-	exchangeRate := resBalance1.Quo(resBalance2)
+	exchangeRate := bond.GetExchangeRate(newReserves, resToken1, resToken2)
 
 	// Get max and min acceptable rates
 	sanityMarginDecimal := bond.SanityMarginPercentage.Quo(sdk.NewDec(100))
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	powerReserves   = []string{TestReserveToken}
+	swapperReserves = []string{TestReserveToken, TestReserveToken2}
+)
+
+func TestGetPricesAtSupply(t *testing.T) {
+	testCases := []struct {
+		functionType string
+		params       FunctionParams
+		supply       int64
+		expected     string
+	}{
+		{PowerFunction, ValidPowerFunctionParams, 0, "100.000000000000000000"},
+		{PowerFunction, ValidPowerFunctionParams, 10, "1300.000000000000000000"},
+		{SigmoidFunction, ValidSigmoidFunctionParams, 5, "3.000000000000000000"},
+		{SigmoidFunction, ValidSigmoidFunctionParams, 0, "0.058257972585248274"},
+	}
+
+	for _, tc := range testCases {
+		bond := NewValidBond(tc.functionType, tc.params, powerReserves)
+		prices, err := bond.GetPricesAtSupply(sdk.NewInt(tc.supply))
+		require.Nil(t, err)
+		require.Equal(t, tc.expected, prices.AmountOf(TestReserveToken).String())
+	}
+
+	for _, functionType := range []string{SwapperFunction, WeightedSwapperFunction} {
+		bond := NewValidBond(functionType, nil, swapperReserves)
+		_, err := bond.GetPricesAtSupply(sdk.OneInt())
+		require.NotNil(t, err)
+	}
+}
+
+func TestCurveIntegral(t *testing.T) {
+	testCases := []struct {
+		functionType string
+		params       FunctionParams
+		supply       int64
+		expected     string
+	}{
+		{PowerFunction, ValidPowerFunctionParams, 0, "0.000000000000000000"},
+		{PowerFunction, ValidPowerFunctionParams, 10, "5000.000000000000000000"},
+		{PowerFunction, ValidPowerFunctionParams, 20, "34000.000000000000000000"},
+		{SigmoidFunction, ValidSigmoidFunctionParams, 0, "0.000000000000000000"},
+		{SigmoidFunction, ValidSigmoidFunctionParams, 5, "2.702941461000000000"},
+	}
+
+	for _, tc := range testCases {
+		bond := NewValidBond(tc.functionType, tc.params, powerReserves)
+		require.Equal(t, tc.expected, bond.CurveIntegral(sdk.NewInt(tc.supply)).String())
+	}
+}
+
+func TestGetPricesToMintAndReturnsForBurn(t *testing.T) {
+	testCases := []struct {
+		supply   int64
+		reserve  sdk.Coins
+		amount   int64
+		toMint   string
+		fromBurn string
+	}{
+		{0, sdk.Coins{}, 10, "5000.000000000000000000", ""},
+		{10, sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 5000)), 10, "29000.000000000000000000", "5000.000000000000000000"},
+		{20, sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 34000)), 10, "77000.000000000000000000", "29000.000000000000000000"},
+	}
+
+	for _, tc := range testCases {
+		bond := NewValidBond(PowerFunction, ValidPowerFunctionParams, powerReserves)
+		bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, tc.supply)
+
+		prices, err := bond.GetPricesToMint(sdk.NewInt(tc.amount), tc.reserve)
+		require.Nil(t, err)
+		require.Equal(t, tc.toMint, prices.AmountOf(TestReserveToken).String())
+
+		if tc.fromBurn != "" {
+			returns := bond.GetReturnsForBurn(sdk.NewInt(tc.amount), tc.reserve)
+			require.Equal(t, tc.fromBurn, returns.AmountOf(TestReserveToken).String())
+		}
+	}
+}
+
+func TestSwapperLiquidityDeltas(t *testing.T) {
+	reserves := sdk.NewCoins(
+		sdk.NewInt64Coin(TestReserveToken, 1000),
+		sdk.NewInt64Coin(TestReserveToken2, 2000))
+
+	for _, functionType := range []string{SwapperFunction, WeightedSwapperFunction} {
+		bond := NewValidBond(functionType, ValidWeightedSwapperFunctionParams, swapperReserves)
+
+		// Minting requires a non-zero supply
+		_, err := bond.GetPricesToMint(sdk.NewInt(10), reserves)
+		require.NotNil(t, err)
+
+		bond.CurrentSupply = sdk.NewInt64Coin(bond.Token, 100)
+
+		prices, err := bond.GetPricesToMint(sdk.NewInt(10), reserves)
+		require.Nil(t, err)
+		require.Equal(t, "100.000000000000000000", prices.AmountOf(TestReserveToken).String())
+		require.Equal(t, "200.000000000000000000", prices.AmountOf(TestReserveToken2).String())
+
+		returns := bond.GetReturnsForBurn(sdk.NewInt(10), reserves)
+		require.Equal(t, prices, returns)
+	}
+}
+
+func TestGetReturnsForSwap(t *testing.T) {
+	testCases := []struct {
+		functionType string
+		params       FunctionParams
+		reserves     sdk.Coins
+		txFee        string
+		from         sdk.Coin
+		expected     int64
+		expectedFee  int64
+	}{
+		{SwapperFunction, nil, sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 2000)),
+			"0", sdk.NewInt64Coin(TestReserveToken, 100), 181, 0},
+		{SwapperFunction, nil, sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 2000)),
+			"10", sdk.NewInt64Coin(TestReserveToken, 100), 165, 10},
+		{WeightedSwapperFunction, FunctionParams{NewFunctionParam(TestReserveToken, sdk.OneInt()), NewFunctionParam(TestReserveToken2, sdk.OneInt())},
+			sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 1000)),
+			"0", sdk.NewInt64Coin(TestReserveToken, 100), 90, 0},
+		{WeightedSwapperFunction, ValidWeightedSwapperFunctionParams,
+			sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 1000)),
+			"0", sdk.NewInt64Coin(TestReserveToken, 100), 31, 0},
+	}
+
+	for _, tc := range testCases {
+		bond := NewValidBond(tc.functionType, tc.params, swapperReserves)
+		bond.TxFeePercentage = sdk.MustNewDecFromStr(tc.txFee)
+
+		returns, fee, err := bond.GetReturnsForSwap(tc.from, TestReserveToken2, tc.reserves)
+		require.Nil(t, err)
+		require.Equal(t, sdk.NewInt64Coin(TestReserveToken2, tc.expected), returns[0])
+		require.Equal(t, sdk.NewInt64Coin(tc.from.Denom, tc.expectedFee), fee)
+	}
+
+	// Swapping into a token that is not a reserve token fails
+	bond := NewValidBond(SwapperFunction, nil, swapperReserves)
+	_, _, err := bond.GetReturnsForSwap(sdk.NewInt64Coin(TestReserveToken, 100), "xyz",
+		sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 2000)))
+	require.NotNil(t, err)
+
+	// Swapping an amount that gives no return fails
+	_, _, err = bond.GetReturnsForSwap(sdk.NewInt64Coin(TestReserveToken, 1), TestReserveToken2,
+		sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 10)))
+	require.NotNil(t, err)
+}
+
+func TestGetFees(t *testing.T) {
+	bond := NewValidBond(PowerFunction, ValidPowerFunctionParams, powerReserves)
+	bond.TxFeePercentage = sdk.MustNewDecFromStr("0.5")
+	bond.ExitFeePercentage = sdk.MustNewDecFromStr("0.1")
+
+	amount := sdk.NewDecCoin(TestReserveToken, sdk.NewInt(1000))
+	require.Equal(t, sdk.NewInt64Coin(TestReserveToken, 5), bond.GetTxFee(amount))
+	require.Equal(t, sdk.NewInt64Coin(TestReserveToken, 1), bond.GetExitFee(amount))
+}
+
+func TestReservesViolateSanityRate(t *testing.T) {
+	testCases := []struct {
+		functionType string
+		params       FunctionParams
+		reserves     sdk.Coins
+		violated     bool
+	}{
+		{SwapperFunction, nil, sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 2000)), false},
+		{SwapperFunction, nil, sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 1500)), true},
+		{WeightedSwapperFunction, ValidWeightedSwapperFunctionParams, sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 6000)), false},
+		{WeightedSwapperFunction, ValidWeightedSwapperFunctionParams, sdk.NewCoins(sdk.NewInt64Coin(TestReserveToken, 1000), sdk.NewInt64Coin(TestReserveToken2, 2000)), true},
+	}
+
+	for _, tc := range testCases {
+		bond := NewValidBond(tc.functionType, tc.params, swapperReserves)
+		bond.SanityRate = sdk.MustNewDecFromStr("0.5")
+		bond.SanityMarginPercentage = sdk.NewDec(10)
+		require.Equal(t, tc.violated, bond.ReservesViolateSanityRate(tc.reserves))
+	}
+}
+
+func TestCheckReserveWeights(t *testing.T) {
+	require.Nil(t, CheckReserveWeights(ValidWeightedSwapperFunctionParams, swapperReserves))
+	require.NotNil(t, CheckReserveWeights(ValidWeightedSwapperFunctionParams, powerReserves))
+	require.NotNil(t, CheckReserveWeights(ValidWeightedSwapperFunctionParams, []string{TestReserveToken, "xyz"}))
+	require.NotNil(t, CheckReserveWeights(FunctionParams{
+		NewFunctionParam(TestReserveToken, sdk.OneInt()),
+		NewFunctionParam(TestReserveToken2, sdk.ZeroInt()),
+	}, swapperReserves))
+	require.Nil(t, CheckReserveWeights(FunctionParams{
+		NewFunctionParam(TestReserveToken, sdk.OneInt()),
+		NewFunctionParam(TestReserveToken2, sdk.NewInt(MaxReserveWeight)),
+	}, swapperReserves))
+	require.NotNil(t, CheckReserveWeights(FunctionParams{
+		NewFunctionParam(TestReserveToken, sdk.OneInt()),
+		NewFunctionParam(TestReserveToken2, sdk.NewInt(MaxReserveWeight+1)),
+	}, swapperReserves))
+}
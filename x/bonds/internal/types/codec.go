@@ -20,6 +20,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&BuyOrder{}, "cosmos-sdk/BuyOrder", nil)
 	cdc.RegisterConcrete(&SellOrder{}, "cosmos-sdk/SellOrder", nil)
 	cdc.RegisterConcrete(&SwapOrder{}, "cosmos-sdk/SwapOrder", nil)
+	cdc.RegisterConcrete(&PricePoint{}, "cosmos-sdk/PricePoint", nil)
+	cdc.RegisterConcrete(&OrderRecord{}, "cosmos-sdk/OrderRecord", nil)
+	cdc.RegisterConcrete(&PendingFeeChange{}, "cosmos-sdk/PendingFeeChange", nil)
+	cdc.RegisterConcrete(&BondEdit{}, "cosmos-sdk/BondEdit", nil)
 	cdc.RegisterConcrete(MsgCreateBond{}, "cosmos-sdk/MsgCreateBond", nil)
 	cdc.RegisterConcrete(MsgEditBond{}, "cosmos-sdk/MsgEditBond", nil)
 	cdc.RegisterConcrete(MsgBuy{}, "cosmos-sdk/MsgBuy", nil)
@@ -46,6 +46,9 @@ const (
 	CodeOrderLimitExceeded     CodeType = 322
 	CodeSanityRateViolated     CodeType = 323
 	CodeFeeTooLarge            CodeType = 324
+
+	// Edits
+	CodeEditorIsNotCreator CodeType = 325
 )
 
 func ErrArgumentCannotBeEmpty(codespace sdk.CodespaceType, argument string) sdk.Error {
@@ -93,6 +96,11 @@ func ErrIncorrectNumberOfReserveTokens(codespace sdk.CodespaceType, expected int
 	return sdk.NewError(codespace, CodeIncorrectNumberOfValues, errMsg)
 }
 
+func ErrTooFewReserveTokens(codespace sdk.CodespaceType, minimum int) sdk.Error {
+	errMsg := fmt.Sprintf("Too few reserve tokens; minimum: %d", minimum)
+	return sdk.NewError(codespace, CodeIncorrectNumberOfValues, errMsg)
+}
+
 func ErrIncorrectNumberOfFunctionParameters(codespace sdk.CodespaceType, expected int) sdk.Error {
 	errMsg := fmt.Sprintf("Incorrect number of function parameters; expected: %d", expected)
 	return sdk.NewError(codespace, CodeIncorrectNumberOfValues, errMsg)
@@ -143,6 +151,16 @@ func ErrInvalidFunctionParameter(codespace sdk.CodespaceType, parameter string)
 	return sdk.NewError(codespace, CodeInvalidFunctionParameter, errMsg)
 }
 
+func ErrReserveTokenWeightMissing(codespace sdk.CodespaceType, denom string) sdk.Error {
+	errMsg := fmt.Sprintf("Weight missing for reserve token '%s'", denom)
+	return sdk.NewError(codespace, CodeInvalidFunctionParameter, errMsg)
+}
+
+func ErrReserveTokenWeightTooLarge(codespace sdk.CodespaceType, denom string, max int64) sdk.Error {
+	errMsg := fmt.Sprintf("Weight for reserve token '%s' cannot be larger than %d", denom, max)
+	return sdk.NewError(codespace, CodeInvalidFunctionParameter, errMsg)
+}
+
 func ErrFunctionNotAvailableForFunctionType(codespace sdk.CodespaceType) sdk.Error {
 	errMsg := "Function is not available for the function type"
 	return sdk.NewError(codespace, CodeFunctionNotAvailableForFunctionType, errMsg)
@@ -227,3 +245,33 @@ func ErrFeesCannotBeOrExceed100Percent(codespace sdk.CodespaceType) sdk.Error {
 	errMsg := "Sum of fees is or exceeds 100 percent"
 	return sdk.NewError(codespace, CodeFeeTooLarge, errMsg)
 }
+
+func ErrInvalidHeightRange(codespace sdk.CodespaceType, fromHeight, toHeight int64) sdk.Error {
+	errMsg := fmt.Sprintf("Invalid height range; from height %d is greater than to height %d", fromHeight, toHeight)
+	return sdk.NewError(codespace, CodeArgumentInvalid, errMsg)
+}
+
+func ErrTooManyCandles(codespace sdk.CodespaceType, maximum int64) sdk.Error {
+	errMsg := fmt.Sprintf("Height range and interval give too many candles; maximum: %d", maximum)
+	return sdk.NewError(codespace, CodeArgumentInvalid, errMsg)
+}
+
+func ErrTooManyOrderRecords(codespace sdk.CodespaceType, maximum uint64) sdk.Error {
+	errMsg := fmt.Sprintf("Limit gives too many order records; maximum: %d", maximum)
+	return sdk.NewError(codespace, CodeArgumentInvalid, errMsg)
+}
+
+func ErrPageTooLarge(codespace sdk.CodespaceType, maximum uint64) sdk.Error {
+	errMsg := fmt.Sprintf("Page gives order records past the last index; maximum: %d", maximum)
+	return sdk.NewError(codespace, CodeArgumentInvalid, errMsg)
+}
+
+func ErrEditorIsNotBondCreator(codespace sdk.CodespaceType, editorDid ixo.Did) sdk.Error {
+	errMsg := fmt.Sprintf("Editor %s is not the bond creator", editorDid)
+	return sdk.NewError(codespace, CodeEditorIsNotCreator, errMsg)
+}
+
+func ErrMaxSupplyBelowSupply(codespace sdk.CodespaceType, maxSupply, supply sdk.Coin) sdk.Error {
+	errMsg := fmt.Sprintf("Max supply %s is less than the supply (including pending buys) %s", maxSupply, supply)
+	return sdk.NewError(codespace, CodeInvalidResultantSupply, errMsg)
+}
@@ -9,6 +9,7 @@ const (
 	EventTypeSwap         = "swap"
 	EventTypeOrderCancel  = "order_cancel"
 	EventTypeOrderFulfill = "order_fulfill"
+	EventTypeFeeChange    = "fee_change"
 
 	AttributeKeyBondDid                = "bond_did"
 	AttributeKeyToken                  = "token"
@@ -40,6 +41,7 @@ const (
 	AttributeKeyChargedFees            = "charged_fees"
 	AttributeKeyReturnedToAddress      = "returned_to_address"
 	AttributeKeyNewBondTokenBalance    = "new_bond_token_balance"
+	AttributeKeyNoticeBatches          = "notice_batches"
 
 	AttributeValueBuyOrder  = "buy"
 	AttributeValueSellOrder = "sell"
@@ -1,14 +1,24 @@
 package types
 
 type GenesisState struct {
-	Bonds   []Bond  `json:"bonds" yaml:"bonds"`
-	Batches []Batch `json:"batches" yaml:"batches"`
+	Bonds             []Bond             `json:"bonds" yaml:"bonds"`
+	Batches           []Batch            `json:"batches" yaml:"batches"`
+	PricePoints       []PricePoint       `json:"price_points" yaml:"price_points"`
+	OrderRecords      []OrderRecord      `json:"order_records" yaml:"order_records"`
+	PendingFeeChanges []PendingFeeChange `json:"pending_fee_changes" yaml:"pending_fee_changes"`
+	BondEdits         []BondEdit         `json:"bond_edits" yaml:"bond_edits"`
 }
 
-func NewGenesisState(bonds []Bond, batches []Batch) GenesisState {
+func NewGenesisState(bonds []Bond, batches []Batch, pricePoints []PricePoint,
+	orderRecords []OrderRecord, pendingFeeChanges []PendingFeeChange,
+	bondEdits []BondEdit) GenesisState {
 	return GenesisState{
-		Bonds:   bonds,
-		Batches: batches,
+		Bonds:             bonds,
+		Batches:           batches,
+		PricePoints:       pricePoints,
+		OrderRecords:      orderRecords,
+		PendingFeeChanges: pendingFeeChanges,
+		BondEdits:         bondEdits,
 	}
 }
 
@@ -19,7 +29,11 @@ func ValidateGenesis(data GenesisState) error {
 
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Bonds:   nil,
-		Batches: nil,
+		Bonds:             nil,
+		Batches:           nil,
+		PricePoints:       nil,
+		OrderRecords:      nil,
+		PendingFeeChanges: nil,
+		BondEdits:         nil,
 	}
 }
@@ -1,6 +1,9 @@
 package types
 
-import "github.com/ixofoundation/ixo-cosmos/x/ixo"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -28,11 +31,27 @@ const (
 // - Batches: 0x01<bond_did_bytes>
 // - Last batches: 0x02<bond_did_bytes>
 // - Bond DIDs: 0x03<bond_token_bytes>
+// - Price points: 0x04<bond_did_bytes>/<height_bytes>
+// - Order records: 0x05<id_bytes>
+// - Account order records index: 0x06<account_did_bytes>/<id_bytes>
+// - Bond order records index: 0x07<bond_did_bytes>/<id_bytes>
+// - Order record count: 0x08
+// - Pending fee changes: 0x09<bond_did_bytes>
+// - Bond edits: 0x0A<bond_did_bytes>/<index_bytes>
+// - Bond edit counts: 0x0B<bond_did_bytes>
 var (
-	BondsKeyPrefix       = []byte{0x00} // key for bonds
-	BatchesKeyPrefix     = []byte{0x01} // key for batches
-	LastBatchesKeyPrefix = []byte{0x02} // key for last batches
-	BondDidsKeyPrefix    = []byte{0x03} // key for bond DIDs
+	BondsKeyPrefix             = []byte{0x00} // key for bonds
+	BatchesKeyPrefix           = []byte{0x01} // key for batches
+	LastBatchesKeyPrefix       = []byte{0x02} // key for last batches
+	BondDidsKeyPrefix          = []byte{0x03} // key for bond DIDs
+	PricePointsKeyPrefix       = []byte{0x04} // key for price points
+	OrderRecordsKeyPrefix      = []byte{0x05} // key for order records
+	AccountOrdersKeyPrefix     = []byte{0x06} // key for account order records index
+	BondOrdersKeyPrefix        = []byte{0x07} // key for bond order records index
+	OrderRecordCountKey        = []byte{0x08} // key for order record count
+	PendingFeeChangesKeyPrefix = []byte{0x09} // key for pending fee changes
+	BondEditsKeyPrefix         = []byte{0x0A} // key for bond edits
+	BondEditCountsKeyPrefix    = []byte{0x0B} // key for bond edit counts
 )
 
 func GetBondKey(bondDid ixo.Did) []byte {
@@ -50,3 +69,47 @@ func GetLastBatchKey(bondDid ixo.Did) []byte {
 func GetBondDidsKey(token string) []byte {
 	return append(BondDidsKeyPrefix, []byte(token)...)
 }
+
+func GetPricePointsKeyPrefix(bondDid ixo.Did) []byte {
+	return append(append(PricePointsKeyPrefix, []byte(bondDid)...), '/')
+}
+
+func GetPricePointKey(bondDid ixo.Did, height int64) []byte {
+	return append(GetPricePointsKeyPrefix(bondDid), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func GetOrderRecordKey(id uint64) []byte {
+	return append(OrderRecordsKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetAccountOrdersKeyPrefix(accountDid ixo.Did) []byte {
+	return append(append(AccountOrdersKeyPrefix, []byte(accountDid)...), '/')
+}
+
+func GetAccountOrderKey(accountDid ixo.Did, id uint64) []byte {
+	return append(GetAccountOrdersKeyPrefix(accountDid), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetBondOrdersKeyPrefix(bondDid ixo.Did) []byte {
+	return append(append(BondOrdersKeyPrefix, []byte(bondDid)...), '/')
+}
+
+func GetBondOrderKey(bondDid ixo.Did, id uint64) []byte {
+	return append(GetBondOrdersKeyPrefix(bondDid), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetPendingFeeChangeKey(bondDid ixo.Did) []byte {
+	return append(PendingFeeChangesKeyPrefix, []byte(bondDid)...)
+}
+
+func GetBondEditsKeyPrefix(bondDid ixo.Did) []byte {
+	return append(append(BondEditsKeyPrefix, []byte(bondDid)...), '/')
+}
+
+func GetBondEditKey(bondDid ixo.Did, index uint64) []byte {
+	return append(GetBondEditsKeyPrefix(bondDid), sdk.Uint64ToBigEndian(index)...)
+}
+
+func GetBondEditCountKey(bondDid ixo.Did) []byte {
+	return append(BondEditCountsKeyPrefix, []byte(bondDid)...)
+}
@@ -108,6 +108,14 @@ func (msg MsgCreateBond) ValidateBasic() sdk.Error {
 		}
 	}
 
+	// Check that weighted swapper has a weight for each reserve token
+	if msg.FunctionType == WeightedSwapperFunction {
+		err := CheckReserveWeights(msg.FunctionParameters, msg.ReserveTokens)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Note: uniqueness of reserve tokens checked when parsing
 
 	return nil
@@ -134,11 +142,17 @@ type MsgEditBond struct { // signBytes should not be changed to sign_bytes becau
 	OrderQuantityLimits    string  `json:"order_quantity_limits" yaml:"order_quantity_limits"`
 	SanityRate             string  `json:"sanity_rate" yaml:"sanity_rate"`
 	SanityMarginPercentage string  `json:"sanity_margin_percentage" yaml:"sanity_margin_percentage"`
+	TxFeePercentage        string  `json:"tx_fee_percentage" yaml:"tx_fee_percentage"`
+	ExitFeePercentage      string  `json:"exit_fee_percentage" yaml:"exit_fee_percentage"`
+	FeeAddress             string  `json:"fee_address" yaml:"fee_address"`
+	MaxSupply              string  `json:"max_supply" yaml:"max_supply"`
+	BatchBlocks            string  `json:"batch_blocks" yaml:"batch_blocks"`
 	EditorDid              ixo.Did `json:"editor_did" yaml:"editor_did"`
 }
 
 func NewMsgEditBond(token, name, description, orderQuantityLimits, sanityRate,
-	sanityMarginPercentage string, editorDid ixo.Did, bondDid sovrin.SovrinDid) MsgEditBond {
+	sanityMarginPercentage, txFeePercentage, exitFeePercentage, feeAddress,
+	maxSupply, batchBlocks string, editorDid ixo.Did, bondDid sovrin.SovrinDid) MsgEditBond {
 	return MsgEditBond{
 		SignBytes:              "",
 		BondDid:                bondDid.Did,
@@ -148,6 +162,11 @@ func NewMsgEditBond(token, name, description, orderQuantityLimits, sanityRate,
 		OrderQuantityLimits:    orderQuantityLimits,
 		SanityRate:             sanityRate,
 		SanityMarginPercentage: sanityMarginPercentage,
+		TxFeePercentage:        txFeePercentage,
+		ExitFeePercentage:      exitFeePercentage,
+		FeeAddress:             feeAddress,
+		MaxSupply:              maxSupply,
+		BatchBlocks:            batchBlocks,
 		EditorDid:              editorDid,
 	}
 }
@@ -166,6 +185,16 @@ func (msg MsgEditBond) ValidateBasic() sdk.Error {
 		return ErrArgumentCannotBeEmpty(DefaultCodespace, "SanityRate")
 	} else if strings.TrimSpace(msg.SanityMarginPercentage) == "" {
 		return ErrArgumentCannotBeEmpty(DefaultCodespace, "SanityMarginPercentage")
+	} else if strings.TrimSpace(msg.TxFeePercentage) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "TxFeePercentage")
+	} else if strings.TrimSpace(msg.ExitFeePercentage) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "ExitFeePercentage")
+	} else if strings.TrimSpace(msg.FeeAddress) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "FeeAddress")
+	} else if strings.TrimSpace(msg.MaxSupply) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "MaxSupply")
+	} else if strings.TrimSpace(msg.BatchBlocks) == "" {
+		return ErrArgumentCannotBeEmpty(DefaultCodespace, "BatchBlocks")
 	} else if strings.TrimSpace(msg.EditorDid) == "" {
 		return ErrArgumentCannotBeEmpty(DefaultCodespace, "EditorDid")
 	}
@@ -175,7 +204,8 @@ func (msg MsgEditBond) ValidateBasic() sdk.Error {
 	// be edited should be "DoNotModifyField", and not an empty string
 	inputList := []string{
 		msg.Name, msg.Description, msg.OrderQuantityLimits,
-		msg.SanityRate, msg.SanityMarginPercentage,
+		msg.SanityRate, msg.SanityMarginPercentage, msg.TxFeePercentage,
+		msg.ExitFeePercentage, msg.FeeAddress, msg.MaxSupply, msg.BatchBlocks,
 	}
 	atLeaseOneEdit := false
 	for _, e := range inputList {
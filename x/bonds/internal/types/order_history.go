@@ -0,0 +1,99 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+const (
+	OrderStatusFulfilled = "fulfilled"
+	OrderStatusCancelled = "cancelled"
+
+	// Maximum number of order records that can be requested in a single query
+	MaxOrderRecordsPerPage uint64 = 100
+)
+
+// OrderRecord is an entry in the order history of an account and of a bond,
+// stored when an order is fulfilled or cancelled
+type OrderRecord struct {
+	Id                uint64       `json:"id" yaml:"id"`
+	BondDid           ixo.Did      `json:"bond_did" yaml:"bond_did"`
+	AccountDid        ixo.Did      `json:"account_did" yaml:"account_did"`
+	Height            int64        `json:"height" yaml:"height"`
+	OrderType         string       `json:"order_type" yaml:"order_type"`
+	Status            string       `json:"status" yaml:"status"`
+	Amount            sdk.Coin     `json:"amount" yaml:"amount"`
+	ToToken           string       `json:"to_token" yaml:"to_token"`
+	ExecutedPrices    sdk.DecCoins `json:"executed_prices" yaml:"executed_prices"`
+	ChargedPrices     sdk.Coins    `json:"charged_prices" yaml:"charged_prices"`
+	ChargedFees       sdk.Coins    `json:"charged_fees" yaml:"charged_fees"`
+	ReturnedToAddress sdk.Coins    `json:"returned_to_address" yaml:"returned_to_address"`
+	TokensMinted      sdk.Int      `json:"tokens_minted" yaml:"tokens_minted"`
+	TokensBurned      sdk.Int      `json:"tokens_burned" yaml:"tokens_burned"`
+	TokensSwapped     sdk.Coins    `json:"tokens_swapped" yaml:"tokens_swapped"`
+	CancelReason      string       `json:"cancel_reason" yaml:"cancel_reason"`
+}
+
+func newOrderRecord(bondDid, accountDid ixo.Did, orderType, status string,
+	amount sdk.Coin) OrderRecord {
+	return OrderRecord{
+		BondDid:      bondDid,
+		AccountDid:   accountDid,
+		OrderType:    orderType,
+		Status:       status,
+		Amount:       amount,
+		TokensMinted: sdk.ZeroInt(),
+		TokensBurned: sdk.ZeroInt(),
+	}
+}
+
+func NewFulfilledBuyRecord(bondDid ixo.Did, bo BuyOrder, prices sdk.DecCoins,
+	chargedPrices, chargedFees, returnedToAddress sdk.Coins) OrderRecord {
+	record := newOrderRecord(bondDid, bo.AccountDid, AttributeValueBuyOrder,
+		OrderStatusFulfilled, bo.Amount)
+	record.ExecutedPrices = prices
+	record.ChargedPrices = chargedPrices
+	record.ChargedFees = chargedFees
+	record.ReturnedToAddress = returnedToAddress
+	record.TokensMinted = bo.Amount.Amount
+	return record
+}
+
+func NewFulfilledSellRecord(bondDid ixo.Did, so SellOrder, prices sdk.DecCoins,
+	chargedFees, returnedToAddress sdk.Coins) OrderRecord {
+	record := newOrderRecord(bondDid, so.AccountDid, AttributeValueSellOrder,
+		OrderStatusFulfilled, so.Amount)
+	record.ExecutedPrices = prices
+	record.ChargedFees = chargedFees
+	record.ReturnedToAddress = returnedToAddress
+	record.TokensBurned = so.Amount.Amount
+	return record
+}
+
+func NewFulfilledSwapRecord(bondDid ixo.Did, so SwapOrder, tokensSwapped,
+	chargedFee sdk.Coin, returnedToAddress sdk.Coins) OrderRecord {
+	record := newOrderRecord(bondDid, so.AccountDid, AttributeValueSwapOrder,
+		OrderStatusFulfilled, so.Amount)
+	record.ToToken = so.ToToken
+	record.ChargedFees = sdk.NewCoins(chargedFee)
+	record.ReturnedToAddress = returnedToAddress
+	record.TokensSwapped = sdk.NewCoins(tokensSwapped)
+	return record
+}
+
+func NewCancelledBuyRecord(bondDid ixo.Did, bo BuyOrder, cancelReason string) OrderRecord {
+	record := newOrderRecord(bondDid, bo.AccountDid, AttributeValueBuyOrder,
+		OrderStatusCancelled, bo.Amount)
+	record.ReturnedToAddress = bo.MaxPrices
+	record.CancelReason = cancelReason
+	return record
+}
+
+func NewCancelledSwapRecord(bondDid ixo.Did, so SwapOrder, cancelReason string) OrderRecord {
+	record := newOrderRecord(bondDid, so.AccountDid, AttributeValueSwapOrder,
+		OrderStatusCancelled, so.Amount)
+	record.ToToken = so.ToToken
+	record.ReturnedToAddress = sdk.Coins{so.Amount}
+	record.CancelReason = cancelReason
+	return record
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+const (
+	// Price points older than this number of blocks are pruned
+	PricePointsMaxAge int64 = 518400
+
+	// Maximum number of candles that can be requested in a single query
+	MaxCandlesPerQuery int64 = 1000
+)
+
+// PricePoint is a snapshot of a bond taken when a batch with orders is performed
+type PricePoint struct {
+	BondDid         ixo.Did      `json:"bond_did" yaml:"bond_did"`
+	Height          int64        `json:"height" yaml:"height"`
+	Time            time.Time    `json:"time" yaml:"time"`
+	BuyPrices       sdk.DecCoins `json:"buy_prices" yaml:"buy_prices"`
+	SellPrices      sdk.DecCoins `json:"sell_prices" yaml:"sell_prices"`
+	Supply          sdk.Coin     `json:"supply" yaml:"supply"`
+	ReserveBalances sdk.Coins    `json:"reserve_balances" yaml:"reserve_balances"`
+	BuyVolume       sdk.Coin     `json:"buy_volume" yaml:"buy_volume"`
+	SellVolume      sdk.Coin     `json:"sell_volume" yaml:"sell_volume"`
+	SwapVolume      sdk.Coins    `json:"swap_volume" yaml:"swap_volume"`
+}
+
+func NewPricePoint(bondDid ixo.Did, height int64, time time.Time,
+	buyPrices, sellPrices sdk.DecCoins, supply sdk.Coin,
+	reserveBalances sdk.Coins, buyVolume, sellVolume sdk.Coin,
+	swapVolume sdk.Coins) PricePoint {
+	return PricePoint{
+		BondDid:         bondDid,
+		Height:          height,
+		Time:            time,
+		BuyPrices:       buyPrices,
+		SellPrices:      sellPrices,
+		Supply:          supply,
+		ReserveBalances: reserveBalances,
+		BuyVolume:       buyVolume,
+		SellVolume:      sellVolume,
+		SwapVolume:      swapVolume,
+	}
+}
+
+// Candle summarises the buy price (in one reserve token) of the price
+// points recorded between StartHeight and EndHeight (both inclusive)
+type Candle struct {
+	StartHeight int64   `json:"start_height" yaml:"start_height"`
+	EndHeight   int64   `json:"end_height" yaml:"end_height"`
+	Open        sdk.Dec `json:"open" yaml:"open"`
+	High        sdk.Dec `json:"high" yaml:"high"`
+	Low         sdk.Dec `json:"low" yaml:"low"`
+	Close       sdk.Dec `json:"close" yaml:"close"`
+	Volume      sdk.Int `json:"volume" yaml:"volume"`
+}
+
+func NewCandle(startHeight, endHeight int64, price sdk.Dec, volume sdk.Int) Candle {
+	return Candle{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Open:        price,
+		High:        price,
+		Low:         price,
+		Close:       price,
+		Volume:      volume,
+	}
+}
+
+func (c Candle) Update(price sdk.Dec, volume sdk.Int) Candle {
+	c.High = sdk.MaxDec(c.High, price)
+	c.Low = sdk.MinDec(c.Low, price)
+	c.Close = price
+	c.Volume = c.Volume.Add(volume)
+	return c
+}
+
+// GetCandles groups price points (sorted by height) into candles of the
+// specified interval (in blocks), starting at fromHeight. Volume is the
+// total amount of bond tokens bought and sold. Intervals without any price
+// points do not produce a candle.
+func GetCandles(points []PricePoint, reserveToken string,
+	fromHeight, toHeight, interval int64) (candles []Candle) {
+
+	var current *Candle
+	for _, p := range points {
+		if p.Height < fromHeight || p.Height > toHeight {
+			continue
+		}
+
+		price := p.BuyPrices.AmountOf(reserveToken)
+		volume := p.BuyVolume.Amount.Add(p.SellVolume.Amount)
+		start := fromHeight + ((p.Height-fromHeight)/interval)*interval
+
+		if current != nil && current.StartHeight == start {
+			*current = current.Update(price, volume)
+			continue
+		}
+
+		if current != nil {
+			candles = append(candles, *current)
+		}
+		end := start + interval - 1
+		if end > toHeight {
+			end = toHeight
+		}
+		candle := NewCandle(start, end, price, volume)
+		current = &candle
+	}
+
+	if current != nil {
+		candles = append(candles, *current)
+	}
+	return candles
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPricePoint(height, price, volume int64) PricePoint {
+	prices := sdk.DecCoins{sdk.NewDecCoin(TestReserveToken, sdk.NewInt(price))}
+	return NewPricePoint(ValidBondDid.Did, height, time.Time{}, prices, prices,
+		sdk.NewInt64Coin(TestToken, 0), nil, sdk.NewInt64Coin(TestToken, volume),
+		sdk.NewInt64Coin(TestToken, 0), nil)
+}
+
+func TestGetCandles(t *testing.T) {
+	points := []PricePoint{
+		newTestPricePoint(10, 5, 1),
+		newTestPricePoint(12, 8, 2),
+		newTestPricePoint(15, 3, 3),
+		newTestPricePoint(19, 4, 4),
+		newTestPricePoint(35, 6, 5),
+	}
+
+	candles := GetCandles(points, TestReserveToken, 10, 39, 10)
+	require.Equal(t, []Candle{
+		{StartHeight: 10, EndHeight: 19, Open: sdk.NewDec(5), High: sdk.NewDec(8),
+			Low: sdk.NewDec(3), Close: sdk.NewDec(4), Volume: sdk.NewInt(10)},
+		{StartHeight: 30, EndHeight: 39, Open: sdk.NewDec(6), High: sdk.NewDec(6),
+			Low: sdk.NewDec(6), Close: sdk.NewDec(6), Volume: sdk.NewInt(5)},
+	}, candles)
+
+	// Points outside of the range are ignored and the last candle is cut short
+	candles = GetCandles(points, TestReserveToken, 12, 16, 3)
+	require.Equal(t, []Candle{
+		{StartHeight: 12, EndHeight: 14, Open: sdk.NewDec(8), High: sdk.NewDec(8),
+			Low: sdk.NewDec(8), Close: sdk.NewDec(8), Volume: sdk.NewInt(2)},
+		{StartHeight: 15, EndHeight: 16, Open: sdk.NewDec(3), High: sdk.NewDec(3),
+			Low: sdk.NewDec(3), Close: sdk.NewDec(3), Volume: sdk.NewInt(3)},
+	}, candles)
+
+	require.Nil(t, GetCandles(points, TestReserveToken, 20, 30, 5))
+}
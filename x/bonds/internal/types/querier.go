@@ -32,3 +32,10 @@ type QuerySwapReturn struct {
 	TotalReturns sdk.Coins `json:"total_returns" yaml:"total_returns"`
 	TotalFees    sdk.Coins `json:"total_fees" yaml:"total_fees"`
 }
+
+type QueryOrderHistory struct {
+	Total  uint64        `json:"total" yaml:"total"`
+	Page   uint64        `json:"page" yaml:"page"`
+	Limit  uint64        `json:"limit" yaml:"limit"`
+	Orders []OrderRecord `json:"orders" yaml:"orders"`
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+)
+
+const (
+	TestToken         = "abc"
+	TestReserveToken  = "res"
+	TestReserveToken2 = "rez"
+)
+
+var (
+	ValidCreatorDid = "4XJLBfGtWSGKSz4BeRxdun"
+	ValidBuyerDid   = "UKzkhVSHc3qEFva5EY2XHt"
+	ValidSellerDid  = "7HjjYKd4SoBv36MjiUbdF6"
+
+	ValidBondDid = sovrin.SovrinDid{
+		Did:       "U7GK8p8rVhJMKhBVRCJJ8c",
+		VerifyKey: "FmwNAfvV2xEqHwszrVJVBR3JgQ8AFCQEVzo1p6x4L8VW",
+	}
+
+	ValidFeeAddress = sdk.AccAddress("ValidFeeAddress")
+
+	ValidPowerFunctionParams = FunctionParams{
+		NewFunctionParam("m", sdk.NewInt(12)),
+		NewFunctionParam("n", sdk.NewInt(2)),
+		NewFunctionParam("c", sdk.NewInt(100)),
+	}
+
+	ValidSigmoidFunctionParams = FunctionParams{
+		NewFunctionParam("a", sdk.NewInt(3)),
+		NewFunctionParam("b", sdk.NewInt(5)),
+		NewFunctionParam("c", sdk.NewInt(1)),
+	}
+
+	ValidWeightedSwapperFunctionParams = FunctionParams{
+		NewFunctionParam(TestReserveToken, sdk.NewInt(1)),
+		NewFunctionParam(TestReserveToken2, sdk.NewInt(3)),
+	}
+)
+
+func NewValidBond(functionType string, functionParams FunctionParams,
+	reserveTokens []string) Bond {
+	return NewBond(TestToken, "test bond", "test bond description",
+		ValidCreatorDid, functionType, functionParams, reserveTokens,
+		ixo.DidToAddr(ValidBondDid.Did), sdk.ZeroDec(), sdk.ZeroDec(),
+		ValidFeeAddress, sdk.NewInt64Coin(TestToken, 1000000), nil,
+		sdk.ZeroDec(), sdk.ZeroDec(), TRUE, sdk.NewUint(1),
+		ValidBondDid.Did, ValidBondDid.VerifyKey)
+}
+
+func NewValidMsgCreateBond(functionType string, functionParams FunctionParams,
+	reserveTokens []string) MsgCreateBond {
+	return NewMsgCreateBond(TestToken, "test bond", "test bond description",
+		ValidCreatorDid, functionType, functionParams, reserveTokens,
+		sdk.ZeroDec(), sdk.ZeroDec(), ValidFeeAddress,
+		sdk.NewInt64Coin(TestToken, 1000000), nil, sdk.ZeroDec(),
+		sdk.ZeroDec(), TRUE, sdk.NewUint(1), ValidBondDid)
+}
+
+// NewValidMsgEditBond returns a MsgEditBond by the creator of the valid
+// bond that does not modify any field
+func NewValidMsgEditBond() MsgEditBond {
+	return NewMsgEditBond(TestToken, DoNotModifyField, DoNotModifyField,
+		DoNotModifyField, DoNotModifyField, DoNotModifyField, DoNotModifyField,
+		DoNotModifyField, DoNotModifyField, DoNotModifyField, DoNotModifyField,
+		ValidCreatorDid, ValidBondDid)
+}
+
+func NewValidSovrinDid(did ixo.Did) sovrin.SovrinDid {
+	return sovrin.SovrinDid{Did: did, VerifyKey: ValidBondDid.VerifyKey}
+}
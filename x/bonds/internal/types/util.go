@@ -1,11 +1,14 @@
 package types
 
 import (
+	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"math/big"
 	"strings"
 )
 
+const maxRootIterations = 300
+
 func SquareRootDec(d sdk.Dec) sdk.Dec {
 	// To find square root of Dec, find square root of big.Int
 	// The precision P is divided by 2 since √(10^P) = 10^(P/2)
@@ -18,6 +21,61 @@ func SquareRootInt(i sdk.Int) sdk.Dec {
 	return SquareRootDec(sdk.NewDecFromInt(i))
 }
 
+func PowerDec(d sdk.Dec, power uint64) sdk.Dec {
+	// Exponentiation by squaring
+	result := sdk.OneDec()
+	for ; power > 0; power >>= 1 {
+		if power&1 == 1 {
+			result = result.Mul(d)
+		}
+		d = d.Mul(d)
+	}
+	return result
+}
+
+func ApproxRootDec(d sdk.Dec, root uint64) sdk.Dec {
+	if d.IsNegative() {
+		panic(fmt.Sprintf("root of negative number %s", d))
+	} else if root == 0 {
+		panic("zeroth root is undefined")
+	} else if d.IsZero() || root == 1 {
+		return d
+	}
+
+	// Newton's method: x' = ((n-1)x + d/x^(n-1)) / n
+	// Starting above the actual root guarantees that x decreases towards
+	// the root, so iteration stops once x no longer decreases
+	x := sdk.MaxDec(d, sdk.OneDec())
+	rootDec := sdk.NewDec(int64(root))
+	rootMinusOne := rootDec.Sub(sdk.OneDec())
+	for i := 0; i < maxRootIterations; i++ {
+		next := rootMinusOne.Mul(x).Add(d.Quo(PowerDec(x, root-1))).Quo(rootDec)
+		if next.GTE(x) {
+			break
+		}
+		x = next
+	}
+	return x
+}
+
+func ApproxPowerDec(d sdk.Dec, numerator, denominator sdk.Int) (sdk.Dec, error) {
+	if !numerator.IsPositive() || !denominator.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("exponent %s/%s must be positive", numerator, denominator)
+	}
+
+	// To find d^(a/b), reduce a/b and find (b-th root of d)^a. Finding the
+	// root first keeps values of d in (0,1) away from zero, reducing the
+	// loss of precision caused by raising such values to large powers
+	gcd := sdk.NewIntFromBigInt((&big.Int{}).GCD(nil, nil,
+		numerator.BigInt(), denominator.BigInt()))
+	a := numerator.Quo(gcd).BigInt()
+	b := denominator.Quo(gcd).BigInt()
+	if !a.IsUint64() || !b.IsUint64() {
+		return sdk.Dec{}, fmt.Errorf("exponent %s/%s is too large", numerator, denominator)
+	}
+	return PowerDec(ApproxRootDec(d, b.Uint64()), a.Uint64()), nil
+}
+
 func RoundReservePrice(p sdk.DecCoin) sdk.Coin {
 	// ReservePrices are rounded up so that the account gets charged more
 	roundedAmount := p.Amount.Ceil().TruncateInt()
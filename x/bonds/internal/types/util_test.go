@@ -0,0 +1,76 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func mustApproxPowerDec(d sdk.Dec, numerator, denominator sdk.Int) sdk.Dec {
+	result, err := ApproxPowerDec(d, numerator, denominator)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func TestRootsAndPowers(t *testing.T) {
+	testCases := []struct {
+		actual   sdk.Dec
+		expected string
+	}{
+		{SquareRootDec(sdk.NewDec(4)), "2.000000000000000000"},
+		{SquareRootDec(sdk.NewDec(2)), "1.414213562000000000"},
+		{PowerDec(sdk.MustNewDecFromStr("1.5"), 3), "3.375000000000000000"},
+		{PowerDec(sdk.NewDec(7), 0), "1.000000000000000000"},
+		{ApproxRootDec(sdk.NewDec(27), 3), "3.000000000000000000"},
+		{ApproxRootDec(sdk.MustNewDecFromStr("0.25"), 2), "0.500000000000000000"},
+		{mustApproxPowerDec(sdk.MustNewDecFromStr("0.25"), sdk.NewInt(1), sdk.NewInt(2)), "0.500000000000000000"},
+		{mustApproxPowerDec(sdk.NewDec(4), sdk.NewInt(6), sdk.NewInt(4)), "8.000000000000000000"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, tc.actual.String())
+	}
+}
+
+func TestApproxPowerDecRejectsInvalidExponents(t *testing.T) {
+	d := sdk.MustNewDecFromStr("0.5")
+	tooLarge := sdk.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 64))
+
+	_, err := ApproxPowerDec(d, sdk.OneInt(), tooLarge)
+	require.Error(t, err)
+	_, err = ApproxPowerDec(d, tooLarge, sdk.NewInt(3))
+	require.Error(t, err)
+	_, err = ApproxPowerDec(d, sdk.OneInt(), sdk.ZeroInt())
+	require.Error(t, err)
+
+	// Exponents that reduce to fit in a uint64 are accepted
+	result, err := ApproxPowerDec(d, tooLarge, tooLarge)
+	require.NoError(t, err)
+	require.Equal(t, d, result)
+}
+
+func TestRounding(t *testing.T) {
+	dc := sdk.NewDecCoinFromDec(TestReserveToken, sdk.MustNewDecFromStr("10.4"))
+
+	require.Equal(t, sdk.NewInt64Coin(TestReserveToken, 11), RoundReservePrice(dc))
+	require.Equal(t, sdk.NewInt64Coin(TestReserveToken, 10), RoundReserveReturn(dc))
+	require.Equal(t, sdk.NewInt64Coin(TestReserveToken, 11), RoundFee(dc))
+}
+
+func TestAdjustFees(t *testing.T) {
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin(TestReserveToken, 10),
+		sdk.NewInt64Coin(TestReserveToken2, 10))
+	maxFees := sdk.NewCoins(
+		sdk.NewInt64Coin(TestReserveToken, 5),
+		sdk.NewInt64Coin(TestReserveToken2, 20))
+
+	expected := sdk.NewCoins(
+		sdk.NewInt64Coin(TestReserveToken, 5),
+		sdk.NewInt64Coin(TestReserveToken2, 10))
+	require.Equal(t, expected, AdjustFees(fees, maxFees))
+}
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/ixofoundation/ixo-cosmos/x/bonds"
+)
+
+const maxGenesisBonds = 5
+
+// RandomGenesisState generates a bonds genesis state with a random number of
+// bonds, each with zero supply and a fresh batch
+func RandomGenesisState(r *rand.Rand, k bonds.Keeper, accs []simulation.Account,
+	reserveTokens []string) bonds.GenesisState {
+
+	var bondsList []bonds.Bond
+	var batches []bonds.Batch
+	tokens := make(map[string]bool)
+	numBonds := r.Intn(maxGenesisBonds + 1)
+	for i := 0; i < numBonds; i++ {
+		msg := randomMsgCreateBond(r, accs, reserveTokens)
+		if tokens[msg.Token] {
+			continue
+		}
+		tokens[msg.Token] = true
+
+		reserveAddress := k.GetReserveAddressByBondCount(sdk.NewInt(int64(len(bondsList))))
+		bond := bonds.NewBond(msg.Token, msg.Name, msg.Description, msg.CreatorDid,
+			msg.FunctionType, msg.FunctionParameters, msg.ReserveTokens,
+			reserveAddress, msg.TxFeePercentage, msg.ExitFeePercentage,
+			msg.FeeAddress, msg.MaxSupply, msg.OrderQuantityLimits, msg.SanityRate,
+			msg.SanityMarginPercentage, msg.AllowSells, msg.BatchBlocks,
+			msg.BondDid, msg.PubKey)
+
+		bondsList = append(bondsList, bond)
+		batches = append(batches, bonds.NewBatch(bond.BondDid, bond.Token, bond.BatchBlocks))
+	}
+
+	return bonds.NewGenesisState(bondsList, batches, nil, nil, nil, nil)
+}
@@ -0,0 +1,330 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/ixofoundation/ixo-cosmos/x/bonds"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+)
+
+const maxOrderAmount = 50
+
+// WeightedOperations returns the bonds operations with the weights used
+// when picking the next operation to simulate
+func WeightedOperations(k bonds.Keeper, reserveTokens []string) []simulation.WeightedOperation {
+	return []simulation.WeightedOperation{
+		{Weight: 10, Op: SimulateMsgCreateBond(k, reserveTokens)},
+		{Weight: 5, Op: SimulateMsgEditBond(k)},
+		{Weight: 40, Op: SimulateMsgBuy(k)},
+		{Weight: 25, Op: SimulateMsgSell(k)},
+		{Weight: 20, Op: SimulateMsgSwap(k)},
+	}
+}
+
+// AccountDid returns the DID that represents a simulation account. Bonds
+// messages are signed by DIDs, so coins used by the simulation should be
+// held by the address derived from this DID.
+func AccountDid(acc simulation.Account) ixo.Did {
+	return base58.Encode(acc.Address[:16])
+}
+
+// AccountAddress returns the address derived from the DID of an account
+func AccountAddress(acc simulation.Account) sdk.AccAddress {
+	return ixo.DidToAddr(AccountDid(acc))
+}
+
+func accountSovrinDid(acc simulation.Account) sovrin.SovrinDid {
+	return sovrin.SovrinDid{
+		Did:       AccountDid(acc),
+		VerifyKey: base58.Encode(acc.PubKey.Bytes()),
+	}
+}
+
+func randomSovrinDid(r *rand.Rand) sovrin.SovrinDid {
+	did := make([]byte, 16)
+	verifyKey := make([]byte, 32)
+	r.Read(did)
+	r.Read(verifyKey)
+	return sovrin.SovrinDid{
+		Did:       base58.Encode(did),
+		VerifyKey: base58.Encode(verifyKey),
+	}
+}
+
+func randomDenom(r *rand.Rand) string {
+	// Bond tokens start with "b" so that they never clash with the reserve
+	// tokens or the staking token used by the simulation
+	letters := "abcdefghijklmnopqrstuvwxyz"
+	denom := []byte("b")
+	for i := 0; i < 5; i++ {
+		denom = append(denom, letters[r.Intn(len(letters))])
+	}
+	return string(denom)
+}
+
+func randomIntBetween(r *rand.Rand, min, max int) sdk.Int {
+	return sdk.NewInt(int64(simulation.RandIntBetween(r, min, max+1)))
+}
+
+func randomReserveTokens(r *rand.Rand, reserveTokens []string, n int) []string {
+	tokens := make([]string, len(reserveTokens))
+	copy(tokens, reserveTokens)
+	r.Shuffle(len(tokens), func(i, j int) {
+		tokens[i], tokens[j] = tokens[j], tokens[i]
+	})
+	return tokens[:n]
+}
+
+func randomMsgCreateBond(r *rand.Rand, accs []simulation.Account,
+	reserveTokens []string) bonds.MsgCreateBond {
+
+	functionTypes := []string{bonds.PowerFunction, bonds.SigmoidFunction,
+		bonds.SwapperFunction, bonds.WeightedSwapperFunction}
+	functionType := functionTypes[r.Intn(len(functionTypes))]
+
+	var tokens []string
+	var params bonds.FunctionParams
+	switch functionType {
+	case bonds.PowerFunction:
+		tokens = randomReserveTokens(r, reserveTokens, simulation.RandIntBetween(r, 1, len(reserveTokens)+1))
+		params = bonds.FunctionParams{
+			bonds.NewFunctionParam("m", randomIntBetween(r, 1, 10)),
+			bonds.NewFunctionParam("n", randomIntBetween(r, 1, 3)),
+			bonds.NewFunctionParam("c", randomIntBetween(r, 1, 100)),
+		}
+	case bonds.SigmoidFunction:
+		tokens = randomReserveTokens(r, reserveTokens, simulation.RandIntBetween(r, 1, len(reserveTokens)+1))
+		params = bonds.FunctionParams{
+			bonds.NewFunctionParam("a", randomIntBetween(r, 1, 10)),
+			bonds.NewFunctionParam("b", randomIntBetween(r, 1, 20)),
+			bonds.NewFunctionParam("c", randomIntBetween(r, 1, 50)),
+		}
+	case bonds.SwapperFunction:
+		tokens = randomReserveTokens(r, reserveTokens, 2)
+	case bonds.WeightedSwapperFunction:
+		tokens = randomReserveTokens(r, reserveTokens, simulation.RandIntBetween(r, 2, len(reserveTokens)+1))
+		for _, t := range tokens {
+			params = append(params, bonds.NewFunctionParam(t, randomIntBetween(r, 1, 5)))
+		}
+	}
+
+	allowSells := "true"
+	if r.Intn(5) == 0 {
+		allowSells = "false"
+	}
+
+	token := randomDenom(r)
+	creator := simulation.RandomAcc(r, accs)
+	feeAcc := simulation.RandomAcc(r, accs)
+	return bonds.NewMsgCreateBond(token, simulation.RandStringOfLength(r, 10),
+		simulation.RandStringOfLength(r, 20), AccountDid(creator), functionType,
+		params, tokens, sdk.NewDecWithPrec(r.Int63n(500), 2),
+		sdk.NewDecWithPrec(r.Int63n(500), 2), AccountAddress(feeAcc),
+		sdk.NewInt64Coin(token, 1000000), nil, sdk.ZeroDec(), sdk.ZeroDec(),
+		allowSells, sdk.NewUint(uint64(simulation.RandIntBetween(r, 1, 4))),
+		randomSovrinDid(r))
+}
+
+// randomBond picks a random bond that satisfies the filter, if any
+func randomBond(r *rand.Rand, ctx sdk.Context, k bonds.Keeper,
+	filter func(bond bonds.Bond) bool) (bond bonds.Bond, found bool) {
+
+	var candidates []bonds.Bond
+	iterator := k.GetBondIterator(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		b := k.MustGetBondByKey(ctx, iterator.Key())
+		if filter(b) {
+			candidates = append(candidates, b)
+		}
+	}
+	iterator.Close()
+
+	if len(candidates) == 0 {
+		return bonds.Bond{}, false
+	}
+	return candidates[r.Intn(len(candidates))], true
+}
+
+func deliver(ctx sdk.Context, handler sdk.Handler, msg sdk.Msg) (
+	opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+	if msg.ValidateBasic() != nil {
+		return simulation.NoOpMsg(bonds.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+	}
+
+	ctx, write := ctx.CacheContext()
+	res := handler(ctx, msg)
+	if res.IsOK() {
+		write()
+	}
+
+	opMsg = simulation.NewOperationMsg(msg, res.IsOK(), res.Log)
+	return opMsg, nil, nil
+}
+
+// SimulateMsgCreateBond generates a MsgCreateBond with random values
+func SimulateMsgCreateBond(k bonds.Keeper, reserveTokens []string) simulation.Operation {
+	handler := bonds.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		msg := randomMsgCreateBond(r, accs, reserveTokens)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgEditBond generates a MsgEditBond with random values, which fails
+// if the new max supply is less than the bond's supply
+func SimulateMsgEditBond(k bonds.Keeper) simulation.Operation {
+	handler := bonds.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		bond, found := randomBond(r, ctx, k, func(bonds.Bond) bool { return true })
+		if !found {
+			return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+		}
+
+		// Each of the governance fields is edited with a probability of 1/3
+		maybe := func(value string) string {
+			if r.Intn(3) == 0 {
+				return value
+			}
+			return bonds.DoNotModifyField
+		}
+		feeAcc := simulation.RandomAcc(r, accs)
+
+		msg := bonds.NewMsgEditBond(bond.Token, simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 20), bonds.DoNotModifyField,
+			bonds.DoNotModifyField, bonds.DoNotModifyField,
+			maybe(sdk.NewDecWithPrec(r.Int63n(500), 2).String()),
+			maybe(sdk.NewDecWithPrec(r.Int63n(500), 2).String()),
+			maybe(AccountAddress(feeAcc).String()),
+			maybe(sdk.NewInt64Coin(bond.Token, int64(simulation.RandIntBetween(r, 1, 2000000))).String()),
+			maybe(fmt.Sprint(simulation.RandIntBetween(r, 1, 4))), bond.CreatorDid,
+			sovrin.SovrinDid{Did: bond.BondDid, VerifyKey: bond.PubKey})
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgBuy generates a MsgBuy with random values. The buyer is willing
+// to pay up to their full balance of each of the bond's reserve tokens.
+func SimulateMsgBuy(k bonds.Keeper) simulation.Operation {
+	handler := bonds.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		bond, found := randomBond(r, ctx, k, func(bonds.Bond) bool { return true })
+		if !found {
+			return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+		}
+
+		buyer := simulation.RandomAcc(r, accs)
+		balances := k.CoinKeeper.GetCoins(ctx, AccountAddress(buyer))
+
+		var maxPrices sdk.Coins
+		for _, t := range bond.ReserveTokens {
+			balance := balances.AmountOf(t)
+			if !balance.IsPositive() {
+				return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+			}
+			if bond.IsSwapper() && bond.CurrentSupply.IsZero() {
+				// First swapper buy provides the initial liquidity
+				balance = simulation.RandomAmount(r, balance)
+				if balance.IsZero() {
+					return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+				}
+			}
+			maxPrices = append(maxPrices, sdk.NewCoin(t, balance))
+		}
+
+		amount := sdk.NewCoin(bond.Token, randomIntBetween(r, 1, maxOrderAmount))
+		msg := bonds.NewMsgBuy(accountSovrinDid(buyer), amount, maxPrices.Sort(), bond.BondDid)
+		return deliver(ctx, handler, msg)
+	}
+}
+
+// SimulateMsgSell generates a MsgSell with random values
+func SimulateMsgSell(k bonds.Keeper) simulation.Operation {
+	handler := bonds.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		bond, found := randomBond(r, ctx, k, func(bond bonds.Bond) bool {
+			return strings.ToLower(bond.AllowSells) == "true" &&
+				bond.CurrentSupply.IsPositive()
+		})
+		if !found {
+			return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+		}
+
+		// Find a random account holding some of the bond token
+		for _, i := range r.Perm(len(accs)) {
+			seller := accs[i]
+			balance := k.CoinKeeper.GetCoins(ctx, AccountAddress(seller)).AmountOf(bond.Token)
+			if !balance.IsPositive() {
+				continue
+			}
+
+			amount := sdk.NewCoin(bond.Token, simulation.RandomAmount(r, balance))
+			if amount.IsZero() {
+				return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+			}
+
+			msg := bonds.NewMsgSell(accountSovrinDid(seller), amount, bond.BondDid)
+			return deliver(ctx, handler, msg)
+		}
+		return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+	}
+}
+
+// SimulateMsgSwap generates a MsgSwap with random values
+func SimulateMsgSwap(k bonds.Keeper) simulation.Operation {
+	handler := bonds.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		bond, found := randomBond(r, ctx, k, func(bond bonds.Bond) bool {
+			return bond.IsSwapper() && bond.CurrentSupply.IsPositive()
+		})
+		if !found {
+			return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+		}
+
+		tokens := randomReserveTokens(r, bond.ReserveTokens, 2)
+		from, to := tokens[0], tokens[1]
+
+		swapper := simulation.RandomAcc(r, accs)
+		balance := k.CoinKeeper.GetCoins(ctx, AccountAddress(swapper)).AmountOf(from)
+		if !balance.IsPositive() {
+			return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+		}
+
+		// Keep swaps small relative to the reserve so that most succeed
+		reserve := k.GetReserveBalances(ctx, bond.BondDid).AmountOf(from)
+		if reserve.IsPositive() && balance.GT(reserve) {
+			balance = reserve
+		}
+
+		amount := sdk.NewCoin(from, simulation.RandomAmount(r, balance))
+		if amount.IsZero() {
+			return simulation.NoOpMsg(bonds.ModuleName), nil, nil
+		}
+
+		msg := bonds.NewMsgSwap(accountSovrinDid(swapper), amount, to, bond.BondDid)
+		return deliver(ctx, handler, msg)
+	}
+}
@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ixofoundation/ixo-cosmos/x/bonds"
+	"github.com/ixofoundation/ixo-cosmos/x/bonds/internal/keeper"
+)
+
+const (
+	numAccounts     = 20
+	numBlocks       = 50
+	maxOpsPerBlock  = 20
+	initialReserves = 10000000000
+)
+
+var (
+	seeds         = []int64{1, 7, 42, 1234, 98765}
+	reserveTokens = []string{"res", "rez", "rex"}
+)
+
+func selectOperation(r *rand.Rand, ops []simulation.WeightedOperation) simulation.Operation {
+	totalWeight := 0
+	for _, op := range ops {
+		totalWeight += op.Weight
+	}
+
+	x := r.Intn(totalWeight)
+	for _, op := range ops {
+		if x < op.Weight {
+			return op.Op
+		}
+		x -= op.Weight
+	}
+	return ops[len(ops)-1].Op
+}
+
+func TestRandomizedBonds(t *testing.T) {
+	for _, seed := range seeds {
+		r := rand.New(rand.NewSource(seed))
+		ctx, k, _ := keeper.CreateTestInput()
+
+		// Fund the DID-derived address of every simulation account
+		accs := simulation.RandomAccounts(r, numAccounts)
+		for _, acc := range accs {
+			var coins sdk.Coins
+			for _, t := range reserveTokens {
+				coins = append(coins, sdk.NewInt64Coin(t, initialReserves))
+			}
+			_, err := k.CoinKeeper.AddCoins(ctx, AccountAddress(acc), coins.Sort())
+			require.Nil(t, err)
+		}
+
+		bonds.InitGenesis(ctx, k, RandomGenesisState(r, k, accs, reserveTokens))
+
+		ops := WeightedOperations(k, reserveTokens)
+		for height := int64(1); height <= numBlocks; height++ {
+			ctx = ctx.WithBlockHeight(height)
+
+			numOps := r.Intn(maxOpsPerBlock + 1)
+			for i := 0; i < numOps; i++ {
+				opMsg, _, err := selectOperation(r, ops)(r, nil, ctx, accs)
+				require.Nil(t, err, "seed %d, height %d: %s", seed, height, opMsg)
+			}
+
+			bonds.EndBlocker(ctx, k)
+
+			res, broken := bonds.AllInvariants(k)(ctx)
+			require.False(t, broken, "seed %d, height %d: %s", seed, height, res)
+		}
+	}
+}
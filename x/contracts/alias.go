@@ -1,6 +1,7 @@
 package contracts
 
 import (
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/client"
 	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/keeper"
 	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/types"
 )
@@ -18,15 +19,33 @@ const (
 	KeyAuthContractAddress                    = types.KeyAuthContractAddress
 	KeyProjectWalletAuthoriserContractAddress = types.KeyProjectWalletAuthoriserContractAddress
 	KeyFoundationWallet                       = types.KeyFoundationWallet
+	KeyWithdrawalRelayerDid                   = types.KeyWithdrawalRelayerDid
+
+	QueryAllContracts           = keeper.QueryAllContracts
+	QueryContractHistory        = keeper.QueryContractHistory
+	QueryPendingContractChanges = keeper.QueryPendingContractChanges
+	
+	ProposalTypeUpdateContractAddresses = types.ProposalTypeUpdateContractAddresses
 )
 
 type (
-	GenesisState = types.GenesisState
-	Keeper       = keeper.Keeper
+	GenesisState                    = types.GenesisState
+	Keeper                          = keeper.Keeper
+	ContractChange                  = types.ContractChange
+	ContractChangeRecord            = types.ContractChangeRecord
+	UpdateContractAddressesProposal = types.UpdateContractAddressesProposal
 )
 
 var (
 	NewKeeper  = keeper.NewKeeper
 	NewQuerier = keeper.NewQuerier
 	ModuleCdc  = types.ModuleCdc
+	
+	RegisterCodec                      = types.RegisterCodec
+	NewContractChange                  = types.NewContractChange
+	NewUpdateContractAddressesProposal = types.NewUpdateContractAddressesProposal
+	ValidateContract                   = types.ValidateContract
+	ValidateChecksumAddress            = types.ValidateChecksumAddress
+	
+	ProposalHandler = client.ProposalHandler
 )
@@ -39,3 +39,47 @@ func GetContractCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetContractHistoryCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getContractHistory",
+		Short: "Get the contract changes applied by governance proposals",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryContractChanges(cdc, keeper.QueryContractHistory)
+		},
+	}
+}
+
+func GetPendingContractChangesCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getPendingContractChanges",
+		Short: "Get the contract changes waiting for their activation height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryContractChanges(cdc, keeper.QueryPendingContractChanges)
+		},
+	}
+}
+
+func queryContractChanges(cdc *codec.Codec, query string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query), nil)
+	if err != nil {
+		return err
+	}
+
+	var changes []types.ContractChangeRecord
+	err = cdc.UnmarshalJSON(bz, &changes)
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(changes, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/spf13/cobra"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/types"
+)
+
+type UpdateContractAddressesProposalJSON struct {
+	Title            string                 `json:"title" yaml:"title"`
+	Description      string                 `json:"description" yaml:"description"`
+	Changes          []types.ContractChange `json:"changes" yaml:"changes"`
+	ActivationHeight int64                  `json:"activationHeight" yaml:"activationHeight"`
+	Deposit          sdk.Coins              `json:"deposit" yaml:"deposit"`
+}
+
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-contract-addresses [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update contract addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update contract addresses along with an initial deposit.
+The proposal details must be supplied via a JSON file. Addresses must be EIP-55 checksummed.
+
+Example:
+$ %s tx gov submit-proposal update-contract-addresses <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Redeploy auth contract",
+  "description": "Use the redeployed auth contract",
+  "changes": [
+    {
+      "key": "authContractAddress",
+      "value": "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+    }
+  ],
+  "activationHeight": "100000",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			proposal := UpdateContractAddressesProposalJSON{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			
+			err = cdc.UnmarshalJSON(contents, &proposal)
+			if err != nil {
+				return err
+			}
+			
+			content := types.NewUpdateContractAddressesProposal(proposal.Title, proposal.Description,
+				proposal.Changes, proposal.ActivationHeight)
+			
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
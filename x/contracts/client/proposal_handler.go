@@ -0,0 +1,10 @@
+package client
+
+import (
+	govClient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/client/cli"
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/client/rest"
+)
+
+var ProposalHandler = govClient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
@@ -15,6 +15,8 @@ import (
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/contracts", queryContractsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/contractHistory", queryContractChangesRequestHandler(cliCtx, keeper.QueryContractHistory)).Methods("GET")
+	r.HandleFunc("/pendingContractChanges", queryContractChangesRequestHandler(cliCtx, keeper.QueryPendingContractChanges)).Methods("GET")
 }
 
 func queryContractsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -34,3 +36,25 @@ func queryContractsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, contracts)
 	}
 }
+
+func queryContractChangesRequestHandler(cliCtx context.CLIContext, query string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		
+		bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, query), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't query contract changes. Error: %s", err.Error())))
+			return
+		}
+		
+		changes := []types.ContractChangeRecord{}
+		err = cliCtx.Codec.UnmarshalJSON(bz, &changes)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Couldn't Unmarshall query result. Error: %s", err.Error())))
+			return
+		}
+		
+		rest.PostProcessResponse(w, cliCtx, changes)
+	}
+}
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govRest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/types"
+)
+
+type UpdateContractAddressesProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	
+	Title            string                 `json:"title" yaml:"title"`
+	Description      string                 `json:"description" yaml:"description"`
+	Changes          []types.ContractChange `json:"changes" yaml:"changes"`
+	ActivationHeight int64                  `json:"activationHeight" yaml:"activationHeight"`
+	Proposer         sdk.AccAddress         `json:"proposer" yaml:"proposer"`
+	Deposit          sdk.Coins              `json:"deposit" yaml:"deposit"`
+}
+
+func ProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "update_contract_addresses",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateContractAddressesProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		
+		content := types.NewUpdateContractAddressesProposal(req.Title, req.Description,
+			req.Changes, req.ActivationHeight)
+		
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -1,9 +1,11 @@
 package contracts
 
 import (
+	"fmt"
 	"strings"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	
 	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/keeper"
@@ -12,64 +14,87 @@ import (
 
 func InitGenesis(ctx sdk.Context, contractKeeper keeper.Keeper, genesisState types.GenesisState) []abciTypes.ValidatorUpdate {
 	
-	address := genesisState.FoundationWallet
-	if isEthAddress(address) {
-		panic("ixo Foundation wallet is not set in genesis file")
-	}
+	contractKeeper.SetContract(ctx, types.KeyFoundationWallet, genesisState.FoundationWallet)
+	contractKeeper.SetContract(ctx, types.KeyAuthContractAddress, genesisState.AuthContractAddress)
+	contractKeeper.SetContract(ctx, types.KeyIxoTokenContractAddress, genesisState.IxoTokenContractAddress)
+	contractKeeper.SetContract(ctx, types.KeyProjectRegistryContractAddress, genesisState.ProjectRegistryContractAddress)
+	contractKeeper.SetContract(ctx, types.KeyProjectWalletAuthoriserContractAddress, genesisState.ProjectWalletAuthoriserAddress)
 	
-	contractKeeper.SetContract(ctx, types.KeyFoundationWallet, address)
-	address = genesisState.AuthContractAddress
-	if isEthAddress(address) {
-		panic("Auth Contract Address is not set in genesis file")
-	}
-	contractKeeper.SetContract(ctx, types.KeyAuthContractAddress, address)
+	// The relayer DID is optional; without it, withdrawals stay pending
+	contractKeeper.SetContract(ctx, types.KeyWithdrawalRelayerDid, genesisState.WithdrawalRelayerDid)
 	
-	address = genesisState.IxoTokenContractAddress
-	if isEthAddress(address) {
-		panic("Ixo ERC20 Token Contract Address is not set in genesis file")
-	}
-	
-	contractKeeper.SetContract(ctx, types.KeyIxoTokenContractAddress, address)
-	address = genesisState.ProjectRegistryContractAddress
-	if isEthAddress(address) {
-		panic("Project Registry Contract Address is not set in genesis file")
-	}
-	
-	contractKeeper.SetContract(ctx, types.KeyProjectRegistryContractAddress, address)
-	address = genesisState.ProjectWalletAuthoriserAddress
-	if isEthAddress(address) {
-		panic("Project Wallet Authoriser Contract Address is not set in genesis file")
-	}
-	
-	contractKeeper.SetContract(ctx, types.KeyProjectWalletAuthoriserContractAddress, address)
+	contractKeeper.SetPendingContractChanges(ctx, genesisState.PendingContractChanges)
+	contractKeeper.SetContractChangeHistory(ctx, genesisState.ContractChangeHistory)
 	
 	return nil
 }
 
-func isEthAddress(address string) bool {
-	return strings.HasPrefix(address, "0x") && len(address) != 42
+func WriteGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
+	
+	return types.GenesisState{
+		AuthContractAddress:            keeper.GetContract(ctx, types.KeyAuthContractAddress),
+		FoundationWallet:               keeper.GetContract(ctx, types.KeyFoundationWallet),
+		IxoTokenContractAddress:        keeper.GetContract(ctx, types.KeyIxoTokenContractAddress),
+		ProjectRegistryContractAddress: keeper.GetContract(ctx, types.KeyProjectRegistryContractAddress),
+		ProjectWalletAuthoriserAddress: keeper.GetContract(ctx, types.KeyProjectWalletAuthoriserContractAddress),
+		WithdrawalRelayerDid:           keeper.GetContract(ctx, types.KeyWithdrawalRelayerDid),
+		PendingContractChanges:         keeper.GetPendingContractChanges(ctx),
+		ContractChangeHistory:          keeper.GetContractChangeHistory(ctx),
+	}
 }
 
-func WriteGenesis(ctx sdk.Context, keeper keeper.Keeper) []*types.GenesisState {
+// ValidateGenesis checks the contract addresses like contract change
+// proposals do, so they must be EIP-55 checksummed
+func ValidateGenesis(data types.GenesisState) error {
+	addresses := []struct {
+		name    string
+		key     string
+		address string
+	}{
+		{"ixo Foundation wallet", types.KeyFoundationWallet, data.FoundationWallet},
+		{"Auth Contract Address", types.KeyAuthContractAddress, data.AuthContractAddress},
+		{"Ixo ERC20 Token Contract Address", types.KeyIxoTokenContractAddress, data.IxoTokenContractAddress},
+		{"Project Registry Contract Address", types.KeyProjectRegistryContractAddress, data.ProjectRegistryContractAddress},
+		{"Project Wallet Authoriser Contract Address", types.KeyProjectWalletAuthoriserContractAddress, data.ProjectWalletAuthoriserAddress},
+	}
+	for _, a := range addresses {
+		if len(strings.TrimSpace(a.address)) == 0 {
+			return fmt.Errorf("%s is not set in genesis file", a.name)
+		} else if err := types.ValidateContract(a.key, a.address); err != nil {
+			return fmt.Errorf("%s: %s", a.name, err.Error())
+		}
+	}
 	
-	return []*types.GenesisState{
-		{
-			AuthContractAddress:            keeper.GetContract(ctx, types.KeyAuthContractAddress),
-			FoundationWallet:               keeper.GetContract(ctx, types.KeyFoundationWallet),
-			IxoTokenContractAddress:        keeper.GetContract(ctx, types.KeyIxoTokenContractAddress),
-			ProjectRegistryContractAddress: keeper.GetContract(ctx, types.KeyProjectRegistryContractAddress),
-			ProjectWalletAuthoriserAddress: keeper.GetContract(ctx, types.KeyProjectWalletAuthoriserContractAddress),
-		},
+	for _, change := range data.PendingContractChanges {
+		if err := types.ValidateContract(change.Key, change.NewValue); err != nil {
+			return fmt.Errorf("pending contract change: %s", err.Error())
+		} else if change.ActivationHeight <= 0 {
+			return fmt.Errorf("pending change to %s has a non-positive activation height", change.Key)
+		}
 	}
+	
+	for _, change := range data.ContractChangeHistory {
+		if err := types.ValidateContract(change.Key, change.NewValue); err != nil {
+			return fmt.Errorf("contract change history: %s", err.Error())
+		} else if change.AppliedHeight <= 0 {
+			return fmt.Errorf("applied change to %s has a non-positive applied height", change.Key)
+		}
+	}
+	
+	return nil
 }
 
+// DefaultGenesis sets every contract to the zero address, which passes
+// validation but has to be replaced with the deployed contracts
 func DefaultGenesis() types.GenesisState {
+	unset := common.Address{}.Hex()
 	
 	return types.GenesisState{
-		IxoTokenContractAddress:        "Enter ETH Ixo Token contract address",
-		AuthContractAddress:            "Enter ETH auth contract address",
-		ProjectWalletAuthoriserAddress: "Enter ETH project wallet authoriser contract address",
-		FoundationWallet:               "Enter ETH wallet address to accumulate foundations tokens",
-		ProjectRegistryContractAddress: "Enter ETH project registry contract address",
+		IxoTokenContractAddress:        unset,
+		AuthContractAddress:            unset,
+		ProjectWalletAuthoriserAddress: unset,
+		FoundationWallet:               unset,
+		ProjectRegistryContractAddress: unset,
+		WithdrawalRelayerDid:           "Enter DID of the withdrawal relayer",
 	}
 }
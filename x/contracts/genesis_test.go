@@ -0,0 +1,81 @@
+package contracts
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmDB "github.com/tendermint/tm-db"
+
+	"github.com/ixofoundation/ixo-cosmos/x/params"
+)
+
+const validAddress = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+func validGenesis() GenesisState {
+	return GenesisState{
+		FoundationWallet:               validAddress,
+		AuthContractAddress:            validAddress,
+		IxoTokenContractAddress:        validAddress,
+		ProjectRegistryContractAddress: validAddress,
+		ProjectWalletAuthoriserAddress: validAddress,
+		WithdrawalRelayerDid:           "did:ixo:relayer",
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.Nil(t, ValidateGenesis(validGenesis()))
+
+	missing := validGenesis()
+	missing.AuthContractAddress = ""
+	require.NotNil(t, ValidateGenesis(missing))
+
+	malformed := validGenesis()
+	malformed.IxoTokenContractAddress = "0x1234"
+	require.NotNil(t, ValidateGenesis(malformed))
+
+	// Addresses must be checksummed, as in contract change proposals
+	unchecksummed := validGenesis()
+	unchecksummed.ProjectRegistryContractAddress = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	require.NotNil(t, ValidateGenesis(unchecksummed))
+
+	placeholder := validGenesis()
+	placeholder.AuthContractAddress = "Enter ETH auth contract address"
+	require.NotNil(t, ValidateGenesis(placeholder))
+
+	require.Nil(t, ValidateGenesis(DefaultGenesis()))
+
+	pending := validGenesis()
+	pending.PendingContractChanges = []ContractChangeRecord{{Key: KeyAuthContractAddress, NewValue: "0x1234", ActivationHeight: 10}}
+	require.NotNil(t, ValidateGenesis(pending))
+
+	history := validGenesis()
+	history.ContractChangeHistory = []ContractChangeRecord{{Key: KeyAuthContractAddress, NewValue: validAddress}}
+	require.NotNil(t, ValidateGenesis(history))
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	ms := store.NewCommitMultiStore(tmDB.NewMemDB())
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abciTypes.Header{Height: 5}, false, log.NewNopLogger())
+	cdc := codec.New()
+	k := NewKeeper(cdc, params.NewKeeper(cdc, keyParams))
+
+	genesis := validGenesis()
+	genesis.PendingContractChanges = []ContractChangeRecord{{
+		ProposalTitle: "later", Key: KeyAuthContractAddress, NewValue: validAddress, ActivationHeight: 10}}
+	genesis.ContractChangeHistory = []ContractChangeRecord{{
+		ProposalTitle: "earlier", Key: KeyFoundationWallet, OldValue: "0xold", NewValue: validAddress,
+		ActivationHeight: 3, AppliedHeight: 3}}
+	require.Nil(t, ValidateGenesis(genesis))
+
+	InitGenesis(ctx, k, genesis)
+	require.Equal(t, genesis, WriteGenesis(ctx, k))
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/types"
+)
+
+const (
+	pendingContractChangesKey = "contractChanges/pending"
+	contractChangeHistoryKey  = "contractChanges/history"
+)
+
+// GetPendingContractChanges returns the changes waiting for their activation
+// height, in the order they were scheduled
+func (k Keeper) GetPendingContractChanges(ctx sdk.Context) []types.ContractChangeRecord {
+	var changes []types.ContractChangeRecord
+	_ = k.paramsKeeper.Getter().Get(ctx, pendingContractChangesKey, &changes)
+	return changes
+}
+
+// GetContractChangeHistory returns the applied changes, oldest first
+func (k Keeper) GetContractChangeHistory(ctx sdk.Context) []types.ContractChangeRecord {
+	var changes []types.ContractChangeRecord
+	_ = k.paramsKeeper.Getter().Get(ctx, contractChangeHistoryKey, &changes)
+	return changes
+}
+
+func (k Keeper) SetPendingContractChanges(ctx sdk.Context, changes []types.ContractChangeRecord) {
+	k.setContractChanges(ctx, pendingContractChangesKey, changes)
+}
+
+func (k Keeper) SetContractChangeHistory(ctx sdk.Context, changes []types.ContractChangeRecord) {
+	k.setContractChanges(ctx, contractChangeHistoryKey, changes)
+}
+
+func (k Keeper) setContractChanges(ctx sdk.Context, key string, changes []types.ContractChangeRecord) {
+	if changes == nil {
+		changes = []types.ContractChangeRecord{}
+	}
+	
+	err := k.paramsKeeper.Setter().Set(ctx, key, changes)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ScheduleContractChange applies the change if its activation height has
+// been reached, and otherwise keeps it pending until then
+func (k Keeper) ScheduleContractChange(ctx sdk.Context, change types.ContractChangeRecord) {
+	if change.ActivationHeight <= ctx.BlockHeight() {
+		k.applyContractChange(ctx, change)
+		return
+	}
+	
+	pending := append(k.GetPendingContractChanges(ctx), change)
+	k.setContractChanges(ctx, pendingContractChangesKey, pending)
+}
+
+// ApplyDueContractChanges applies the pending changes whose activation height
+// has been reached
+func (k Keeper) ApplyDueContractChanges(ctx sdk.Context) {
+	pending := k.GetPendingContractChanges(ctx)
+	if len(pending) == 0 {
+		return
+	}
+	
+	var remaining []types.ContractChangeRecord
+	for _, change := range pending {
+		if change.ActivationHeight <= ctx.BlockHeight() {
+			k.applyContractChange(ctx, change)
+		} else {
+			remaining = append(remaining, change)
+		}
+	}
+	
+	k.setContractChanges(ctx, pendingContractChangesKey, remaining)
+}
+
+func (k Keeper) applyContractChange(ctx sdk.Context, change types.ContractChangeRecord) {
+	change.OldValue = k.paramsKeeper.Getter().GetStringWithDefault(ctx, MakeContractKey(change.Key), "")
+	change.AppliedHeight = ctx.BlockHeight()
+	k.SetContract(ctx, change.Key, change.NewValue)
+	
+	history := append(k.GetContractChangeHistory(ctx), change)
+	k.setContractChanges(ctx, contractChangeHistoryKey, history)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmDB "github.com/tendermint/tm-db"
+
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/params"
+)
+
+func TestScheduleContractChange(t *testing.T) {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	ms := store.NewCommitMultiStore(tmDB.NewMemDB())
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, nil)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abciTypes.Header{Height: 5}, false, log.NewNopLogger())
+	cdc := codec.New()
+	k := NewKeeper(cdc, params.NewKeeper(cdc, keyParams))
+	k.SetContract(ctx, types.KeyAuthContractAddress, "0xold")
+
+	// Activation height reached, so the change is applied straight away
+	change := types.NewContractChange(types.KeyAuthContractAddress, "0xnow")
+	k.ScheduleContractChange(ctx, types.NewContractChangeRecord("now", change, 5))
+	require.Equal(t, "0xnow", k.GetContract(ctx, types.KeyAuthContractAddress))
+
+	// Activation height in the future, so the change stays pending
+	change = types.NewContractChange(types.KeyAuthContractAddress, "0xlater")
+	k.ScheduleContractChange(ctx, types.NewContractChangeRecord("later", change, 7))
+	require.Equal(t, "0xnow", k.GetContract(ctx, types.KeyAuthContractAddress))
+	require.Len(t, k.GetPendingContractChanges(ctx), 1)
+
+	k.ApplyDueContractChanges(ctx.WithBlockHeight(6))
+	require.Equal(t, "0xnow", k.GetContract(ctx, types.KeyAuthContractAddress))
+
+	k.ApplyDueContractChanges(ctx.WithBlockHeight(7))
+	require.Equal(t, "0xlater", k.GetContract(ctx, types.KeyAuthContractAddress))
+	require.Empty(t, k.GetPendingContractChanges(ctx))
+
+	history := k.GetContractChangeHistory(ctx)
+	require.Len(t, history, 2)
+	require.Equal(t, "0xold", history[0].OldValue)
+	require.Equal(t, int64(5), history[0].AppliedHeight)
+	require.Equal(t, "0xnow", history[1].OldValue)
+	require.Equal(t, "0xlater", history[1].NewValue)
+	require.Equal(t, int64(7), history[1].AppliedHeight)
+}
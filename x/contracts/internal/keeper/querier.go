@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	
@@ -11,7 +12,9 @@ import (
 )
 
 const (
-	QueryAllContracts = "queryAllContracts"
+	QueryAllContracts           = "queryAllContracts"
+	QueryContractHistory        = "queryContractHistory"
+	QueryPendingContractChanges = "queryPendingContractChanges"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -19,6 +22,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryAllContracts:
 			return queryAllContracts(ctx, k)
+		case QueryContractHistory:
+			return queryContractChanges(k, k.GetContractChangeHistory(ctx))
+		case QueryPendingContractChanges:
+			return queryContractChanges(k, k.GetPendingContractChanges(ctx))
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown did query endpoint")
 		}
@@ -40,3 +47,16 @@ func queryAllContracts(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	
 	return res, nil
 }
+
+func queryContractChanges(k Keeper, changes []types.ContractChangeRecord) ([]byte, sdk.Error) {
+	if changes == nil {
+		changes = []types.ContractChangeRecord{}
+	}
+	
+	res, errRes := codec.MarshalJSONIndent(k.cdc, changes)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+	
+	return res, nil
+}
@@ -6,7 +6,12 @@ import (
 
 var ModuleCdc *codec.Codec
 
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(UpdateContractAddressesProposal{}, "contracts/UpdateContractAddressesProposal", nil)
+}
+
 func init() {
 	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
 	ModuleCdc.Seal()
 }
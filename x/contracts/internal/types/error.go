@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	CodeInvalidContractKey     sdk.CodeType = 101
+	CodeInvalidContractAddress sdk.CodeType = 102
+	CodeInvalidProposal        sdk.CodeType = 103
+)
+
+func ErrInvalidContractKey(key string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeInvalidContractKey,
+		"Invalid contract key "+key)
+}
+
+func ErrInvalidContractAddress(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeInvalidContractAddress, msg)
+}
+
+func ErrInvalidProposal(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeInvalidProposal, msg)
+}
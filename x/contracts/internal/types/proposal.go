@@ -0,0 +1,170 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	ProposalTypeUpdateContractAddresses = "UpdateContractAddresses"
+)
+
+var _ govTypes.Content = UpdateContractAddressesProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeUpdateContractAddresses)
+	govTypes.RegisterProposalTypeCodec(UpdateContractAddressesProposal{}, "contracts/UpdateContractAddressesProposal")
+}
+
+// ContractChange sets one of the contracts (see AllContracts) to a new value
+type ContractChange struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+func NewContractChange(key, value string) ContractChange {
+	return ContractChange{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// UpdateContractAddressesProposal changes contract addresses once the chain
+// reaches the activation height, so that relayers and validators have time to
+// switch over to redeployed contracts. If the proposal passes after the
+// activation height, the changes are applied straight away.
+type UpdateContractAddressesProposal struct {
+	Title            string           `json:"title" yaml:"title"`
+	Description      string           `json:"description" yaml:"description"`
+	Changes          []ContractChange `json:"changes" yaml:"changes"`
+	ActivationHeight int64            `json:"activationHeight" yaml:"activationHeight"`
+}
+
+func NewUpdateContractAddressesProposal(title, description string, changes []ContractChange,
+	activationHeight int64) UpdateContractAddressesProposal {
+	return UpdateContractAddressesProposal{
+		Title:            title,
+		Description:      description,
+		Changes:          changes,
+		ActivationHeight: activationHeight,
+	}
+}
+
+func (p UpdateContractAddressesProposal) GetTitle() string       { return p.Title }
+func (p UpdateContractAddressesProposal) GetDescription() string { return p.Description }
+func (p UpdateContractAddressesProposal) ProposalRoute() string  { return RouterKey }
+func (p UpdateContractAddressesProposal) ProposalType() string {
+	return ProposalTypeUpdateContractAddresses
+}
+
+func (p UpdateContractAddressesProposal) ValidateBasic() sdk.Error {
+	err := govTypes.ValidateAbstract(DefaultCodeSpace, p)
+	if err != nil {
+		return err
+	}
+	
+	if len(p.Changes) == 0 {
+		return ErrInvalidProposal("Proposal must contain at least one change")
+	} else if p.ActivationHeight <= 0 {
+		return ErrInvalidProposal("Activation height must be positive")
+	}
+	
+	seen := make(map[string]bool)
+	for _, change := range p.Changes {
+		if seen[change.Key] {
+			return ErrInvalidProposal("Duplicate change to " + change.Key)
+		}
+		seen[change.Key] = true
+	
+		err := ValidateContract(change.Key, change.Value)
+		if err != nil {
+			return err
+		}
+	}
+	
+	return nil
+}
+
+func (p UpdateContractAddressesProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Contract Addresses Proposal:
+  Title:             %s
+  Description:       %s
+  Activation Height: %d
+  Changes:
+`, p.Title, p.Description, p.ActivationHeight))
+	
+	for _, change := range p.Changes {
+		b.WriteString(fmt.Sprintf("    %s: %s\n", change.Key, change.Value))
+	}
+	
+	return b.String()
+}
+
+// ValidateContract checks that the key is one of AllContracts and that the
+// value is a valid EIP-55 checksummed Ethereum address. The withdrawal
+// relayer is a DID rather than an address, so it only has to be non-empty.
+func ValidateContract(key, value string) sdk.Error {
+	if !isContractKey(key) {
+		return ErrInvalidContractKey(key)
+	}
+	
+	if key == KeyWithdrawalRelayerDid {
+		if len(strings.TrimSpace(value)) == 0 {
+			return ErrInvalidContractAddress("Withdrawal relayer DID cannot be empty")
+		}
+		return nil
+	}
+	
+	return ValidateChecksumAddress(value)
+}
+
+// ValidateChecksumAddress checks that the address is a 0x-prefixed Ethereum
+// address with a valid EIP-55 mixed-case checksum
+func ValidateChecksumAddress(address string) sdk.Error {
+	if !strings.HasPrefix(address, "0x") || !common.IsHexAddress(address) {
+		return ErrInvalidContractAddress(fmt.Sprintf("%s is not an Ethereum address", address))
+	}
+	
+	checksummed := common.HexToAddress(address).Hex()
+	if address != checksummed {
+		return ErrInvalidContractAddress(fmt.Sprintf("%s has an invalid checksum, expected %s",
+			address, checksummed))
+	}
+	
+	return nil
+}
+
+func isContractKey(key string) bool {
+	for _, contract := range AllContracts {
+		if contract == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ContractChangeRecord is a contract change made by a proposal. It is
+// pending until the chain reaches the activation height, at which point the
+// previous value and the height it was applied at are filled in.
+type ContractChangeRecord struct {
+	ProposalTitle    string `json:"proposalTitle" yaml:"proposalTitle"`
+	Key              string `json:"key" yaml:"key"`
+	OldValue         string `json:"oldValue" yaml:"oldValue"`
+	NewValue         string `json:"newValue" yaml:"newValue"`
+	ActivationHeight int64  `json:"activationHeight" yaml:"activationHeight"`
+	AppliedHeight    int64  `json:"appliedHeight" yaml:"appliedHeight"`
+}
+
+func NewContractChangeRecord(proposalTitle string, change ContractChange, activationHeight int64) ContractChangeRecord {
+	return ContractChangeRecord{
+		ProposalTitle:    proposalTitle,
+		Key:              change.Key,
+		NewValue:         change.Value,
+		ActivationHeight: activationHeight,
+	}
+}
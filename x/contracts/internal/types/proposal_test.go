@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validChecksumAddress   = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	invalidChecksumAddress = "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+)
+
+func TestValidateChecksumAddress(t *testing.T) {
+	require.Nil(t, ValidateChecksumAddress(validChecksumAddress))
+	require.NotNil(t, ValidateChecksumAddress(invalidChecksumAddress))
+	require.NotNil(t, ValidateChecksumAddress("5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	require.NotNil(t, ValidateChecksumAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA"))
+	require.NotNil(t, ValidateChecksumAddress("Enter ETH auth contract address"))
+}
+
+func TestUpdateContractAddressesProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		changes          []ContractChange
+		activationHeight int64
+		valid            bool
+	}{
+		{[]ContractChange{NewContractChange(KeyAuthContractAddress, validChecksumAddress)}, 10, true},
+		{[]ContractChange{NewContractChange(KeyWithdrawalRelayerDid, "did:ixo:U4tSpzzv91HHqWW1YmFkHJ")}, 10, true},
+		{[]ContractChange{NewContractChange(KeyAuthContractAddress, invalidChecksumAddress)}, 10, false},
+		{[]ContractChange{NewContractChange("unknownContract", validChecksumAddress)}, 10, false},
+		{[]ContractChange{NewContractChange(KeyWithdrawalRelayerDid, "")}, 10, false},
+		{[]ContractChange{NewContractChange(KeyAuthContractAddress, validChecksumAddress)}, 0, false},
+		{[]ContractChange{}, 10, false},
+		{[]ContractChange{
+			NewContractChange(KeyAuthContractAddress, validChecksumAddress),
+			NewContractChange(KeyAuthContractAddress, validChecksumAddress),
+		}, 10, false},
+	}
+
+	for i, tc := range testCases {
+		p := NewUpdateContractAddressesProposal("title", "description", tc.changes, tc.activationHeight)
+		err := p.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "test case %d", i)
+		} else {
+			require.NotNil(t, err, "test case %d", i)
+		}
+	}
+}
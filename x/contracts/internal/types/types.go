@@ -6,6 +6,7 @@ const (
 	KeyIxoTokenContractAddress                = "ixoTokenContractAddress"
 	KeyProjectRegistryContractAddress         = "projectRegistryContractAddress"
 	KeyProjectWalletAuthoriserContractAddress = "projectWalletAuthoriserAddress"
+	KeyWithdrawalRelayerDid                   = "withdrawalRelayerDid"
 )
 
 var AllContracts = []string{
@@ -14,6 +15,7 @@ var AllContracts = []string{
 	KeyIxoTokenContractAddress,
 	KeyProjectRegistryContractAddress,
 	KeyProjectWalletAuthoriserContractAddress,
+	KeyWithdrawalRelayerDid,
 }
 
 type GenesisState struct {
@@ -22,4 +24,8 @@ type GenesisState struct {
 	IxoTokenContractAddress        string `json:"ixoTokenContractAddress"`
 	ProjectRegistryContractAddress string `json:"projectRegistryContractAddress"`
 	ProjectWalletAuthoriserAddress string `json:"projectWalletAuthoriserAddress"`
+	WithdrawalRelayerDid           string `json:"withdrawalRelayerDid"`
+	
+	PendingContractChanges []ContractChangeRecord `json:"pendingContractChanges"`
+	ContractChangeHistory  []ContractChangeRecord `json:"contractChangeHistory"`
 }
@@ -31,6 +31,7 @@ func (AppModuleBasic) Name() string {
 }
 
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
 }
 
 func (AppModuleBasic) DefaultGenesis() json.RawMessage {
@@ -38,7 +39,13 @@ func (AppModuleBasic) DefaultGenesis() json.RawMessage {
 }
 
 func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
-	return nil
+	var data GenesisState
+	err := ModuleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+
+	return ValidateGenesis(data)
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
@@ -60,6 +67,8 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	contractQueryCmd.AddCommand(client.GetCommands(
 		cli.GetContractCmd(cdc),
+		cli.GetContractHistoryCmd(cdc),
+		cli.GetPendingContractChangesCmd(cdc),
 	)...)
 
 	return contractQueryCmd
@@ -108,10 +117,11 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abciTyp
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	return nil
+	return ModuleCdc.MustMarshalJSON(WriteGenesis(ctx, am.keeper))
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abciTypes.RequestBeginBlock) {
+	am.keeper.ApplyDueContractChanges(ctx)
 }
 
 func (AppModule) EndBlock(_ sdk.Context, _ abciTypes.RequestEndBlock) []abciTypes.ValidatorUpdate {
@@ -0,0 +1,38 @@
+package contracts
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/contracts/internal/types"
+)
+
+func NewProposalHandler(k keeper.Keeper) gov.Handler {
+	return func(ctx sdk.Context, content gov.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.UpdateContractAddressesProposal:
+			return handleUpdateContractAddressesProposal(ctx, k, c)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized contracts proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleUpdateContractAddressesProposal(ctx sdk.Context, k keeper.Keeper,
+	p types.UpdateContractAddressesProposal) sdk.Error {
+	
+	err := p.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	
+	for _, change := range p.Changes {
+		k.ScheduleContractChange(ctx, types.NewContractChangeRecord(p.Title, change, p.ActivationHeight))
+	}
+	
+	return nil
+}
@@ -11,12 +11,50 @@ const (
 	RouterKey    = types.RouterKey
 	StoreKey     = types.StoreKey
 	
+	StoreVersion = keeper.StoreVersion
+	
 	DefaultCodeSpace = types.DefaultCodeSpace
+	
+	AuthenticationPurpose = types.AuthenticationPurpose
+	AssertionPurpose      = types.AssertionPurpose
 )
 
 type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
+	BaseDidDoc   = types.BaseDidDoc
+	
+	DidMigrationReport = types.DidMigrationReport
+	UnderivedDid       = types.UnderivedDid
+	
+	QueryDidsParams     = types.QueryDidsParams
+	QueryDidsResult     = types.QueryDidsResult
+	QueryDidDocsResult  = types.QueryDidDocsResult
+	QueryDidCountResult = types.QueryDidCountResult
+	
+	DidDocument         = types.DidDocument
+	VerificationMethod  = types.VerificationMethod
+	Service             = types.Service
+	DidResolutionResult = types.DidResolutionResult
+	
+	VerificationKey          = types.VerificationKey
+	KeyPurpose               = types.KeyPurpose
+	AddServiceMsg            = types.AddServiceMsg
+	RemoveServiceMsg         = types.RemoveServiceMsg
+	AddVerificationKeyMsg    = types.AddVerificationKeyMsg
+	RemoveVerificationKeyMsg = types.RemoveVerificationKeyMsg
+	
+	AnchoredCredential         = types.AnchoredCredential
+	CredentialProof            = types.CredentialProof
+	AddVerifiableCredentialMsg = types.AddVerifiableCredentialMsg
+	
+	Delegation          = types.Delegation
+	AddControllerMsg    = types.AddControllerMsg
+	RemoveControllerMsg = types.RemoveControllerMsg
+	SetDelegationMsg    = types.SetDelegationMsg
+	RemoveDelegationMsg = types.RemoveDelegationMsg
+	
+	SetKeyAgreementKeyMsg = types.SetKeyAgreementKeyMsg
 )
 
 var (
@@ -24,6 +62,9 @@ var (
 	NewQuerier    = keeper.NewQuerier
 	RegisterCodec = types.RegisterCodec
 	ModuleCdc     = types.ModuleCdc
+	InitDidDoc    = types.InitDidDoc
+	
+	NewDidDocument = types.NewDidDocument
 	
 	DefaultGenesisState = types.DefaultGenesisState
 	ValidateGenesis     = types.ValidateGenesis
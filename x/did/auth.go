@@ -1,8 +1,8 @@
 package did
 
 import (
-	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 	
 	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
@@ -18,21 +18,21 @@ func NewAnteHandler(didKeeper Keeper) sdk.AnteHandler {
 		
 		msg := ixoTx.GetMsgs()[0]
 		didMsg := msg.(types.DidMsg)
-		pubKey := [32]byte{}
+		var pubKeys []crypto.PubKey
 		
 		if didMsg.IsNewDid() {
 			addDidMsg := didMsg.(types.AddDidMsg)
-			copy(pubKey[:], base58.Decode(addDidMsg.DidDoc.PubKey))
+			pubKeys = []crypto.PubKey{ixo.Ed25519PubKey(addDidMsg.DidDoc.PubKey)}
 		} else {
 			did := ixo.Did(msg.GetSigners()[0])
-			didDoc, _ := didKeeper.GetDidDoc(ctx, did)
-			if didDoc == nil {
+			authKeys, err := didKeeper.GetSigningKeys(ctx, did, msg)
+			if err != nil {
 				return ctx,
 					sdk.ErrUnauthorized("Issuer did not found").Result(),
 					true
 			}
 			
-			copy(pubKey[:], base58.Decode(didDoc.GetPubKey()))
+			pubKeys = authKeys
 		}
 		
 		var sigs = ixoTx.GetSignatures()
@@ -42,7 +42,7 @@ func NewAnteHandler(didKeeper Keeper) sdk.AnteHandler {
 				true
 		}
 		
-		res := ixo.VerifySignature(msg, pubKey, sigs[0])
+		res := ixo.VerifySignatureWithAnyPubKey(msg, pubKeys, sigs[0])
 		
 		if !res {
 			return ctx, sdk.ErrInternal("Signature Verification failed").Result(), true
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+)
+
+// GetDidCmd returns the did commands that work with DID keys on the client,
+// such as managing the DIDs of the keyring and encrypting data for the key
+// agreement key of a DID
+func GetDidCmd(cdc *codec.Codec) *cobra.Command {
+	didCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "DID key utilities",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	didCmd.AddCommand(GetKeysCmd())
+	didCmd.AddCommand(client.GetCommands(FromDidCommands(
+		EncryptCmd(cdc),
+		DecryptCmd(cdc),
+	)...)...)
+
+	return didCmd
+}
+
+func EncryptCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "encrypt [recipient-did] [message]",
+		Short: "Encrypt a message for the key agreement key of a DID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// Recipients only accept messages sealed with the key agreement key
+			// published by the sender
+			senderDidDoc, err := queryDidDoc(ctx, sovrinDid.Did)
+			if err != nil {
+				return err
+			} else if senderDidDoc.KeyAgreementKey != sovrinDid.EncryptionPublicKey {
+				return fmt.Errorf("%s has not published its encryption public key as its key agreement key, "+
+					"see 'setKeyAgreementKey'", sovrinDid.Did)
+			}
+
+			recipientDidDoc, err := queryDidDoc(ctx, args[0])
+			if err != nil {
+				return err
+			} else if recipientDidDoc.KeyAgreementKey == "" {
+				return fmt.Errorf("%s has not published a key agreement key", args[0])
+			}
+
+			encrypted, err := sovrin.SealMessage([]byte(args[1]), recipientDidDoc.KeyAgreementKey, sovrinDid)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(encrypted.String())
+			return nil
+		},
+	}
+}
+
+func DecryptCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "decrypt [encrypted-message]",
+		Short: "Decrypt a message encrypted for a DID and check that it was sent by the sender DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			encrypted := sovrin.EncryptedMessage{}
+			if err := json.Unmarshal([]byte(args[0]), &encrypted); err != nil {
+				return err
+			}
+
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			senderDidDoc, err := queryDidDoc(ctx, encrypted.SenderDid)
+			if err != nil {
+				return err
+			} else if senderDidDoc.KeyAgreementKey != encrypted.SenderKey {
+				return fmt.Errorf("message was not encrypted with the key agreement key of %s", encrypted.SenderDid)
+			}
+
+			message, err := sovrin.OpenMessage(encrypted, sovrinDid)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(message))
+			return nil
+		},
+	}
+}
+
+func queryDidDoc(ctx context.CLIContext, did ixo.Did) (types.BaseDidDoc, error) {
+	res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+		keeper.QueryDidDoc, did), nil)
+	if err != nil {
+		return types.BaseDidDoc{}, err
+	} else if len(res) == 0 {
+		return types.BaseDidDoc{}, errors.New("response bytes are empty")
+	}
+
+	var didDoc types.BaseDidDoc
+	if err := json.Unmarshal(res, &didDoc); err != nil {
+		return types.BaseDidDoc{}, err
+	}
+
+	return didDoc, nil
+}
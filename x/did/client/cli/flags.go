@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
+)
+
+const (
+	FlagCredentialType = "credential-type"
+	FlagCreatedAfter   = "created-after"
+	FlagAfter          = "after"
+	FlagPage           = "page"
+	FlagLimit          = "limit"
+)
+
+func addQueryDidsFlags(cmd *cobra.Command, paginated bool) {
+	cmd.Flags().String(FlagCredentialType, "", "Only include DIDs holding a credential of this type")
+	cmd.Flags().Int64(FlagCreatedAfter, 0,
+		"Only include DIDs created after this block height (never matches DIDs created before heights were recorded)")
+	if paginated {
+		cmd.Flags().String(FlagAfter, "", "Start after this DID, e.g. the nextKey of the previous page")
+		cmd.Flags().Uint64(FlagPage, 1, "Page of results to return (prefer --after for later pages)")
+		cmd.Flags().Uint64(FlagLimit, types.DefaultDidsPerPage, "Number of DIDs per page")
+	}
+}
+
+func queryDidsParamsFromFlags() types.QueryDidsParams {
+	return types.NewQueryDidsParams(viper.GetString(FlagCredentialType), viper.GetInt64(FlagCreatedAfter),
+		viper.GetString(FlagAfter), viper.GetUint64(FlagPage), viper.GetUint64(FlagLimit))
+}
@@ -0,0 +1,401 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	ckeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+)
+
+const (
+	FlagFromDid = "from-did"
+	FlagRecover = "recover"
+)
+
+// DidKeyOutput describes a sovrin DID stored in the keyring, without its
+// secrets
+type DidKeyOutput struct {
+	Name      string  `json:"name"`
+	Did       ixo.Did `json:"did"`
+	VerifyKey string  `json:"verifyKey"`
+}
+
+// GetKeysCmd returns the commands that manage sovrin DIDs in the keyring.
+// The ed25519 key of a DID is stored encrypted alongside the cosmos keys,
+// and the rest of the sovrin DID is derived from it when it is used.
+func GetKeysCmd() *cobra.Command {
+	keysCmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage the sovrin DIDs of the keyring",
+	}
+
+	keysCmd.AddCommand(
+		AddDidKeyCmd(),
+		ListDidKeysCmd(),
+		ShowDidKeyCmd(),
+		ExportDidKeyCmd(),
+		ImportDidKeyCmd(),
+	)
+
+	return keysCmd
+}
+
+func AddDidKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [name]",
+		Short: "Generate a new sovrin DID, or recover one from its mnemonic, and store it in the keyring",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+
+			mnemonic := sovrin.GenerateMnemonic()
+			if viper.GetBool(FlagRecover) {
+				var err error
+				mnemonic, err = input.GetString("Enter your mnemonic", buf)
+				if err != nil {
+					return err
+				} else if !bip39.IsMnemonicValid(mnemonic) {
+					return errors.New("invalid mnemonic")
+				}
+			}
+
+			sovrinDid := sovrin.FromMnemonic(mnemonic)
+			if err := storeSovrinDid(args[0], sovrinDid, buf); err != nil {
+				return err
+			}
+
+			if err := printOutput(newDidKeyOutput(args[0], sovrinDid.VerifyKey)); err != nil {
+				return err
+			}
+
+			if !viper.GetBool(FlagRecover) {
+				fmt.Println("\n**Important** write this mnemonic phrase in a safe place.")
+				fmt.Println("It is the only way to recover your DID if you forget your password.")
+				fmt.Println()
+				fmt.Println(mnemonic)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(FlagRecover, false, "Recover the DID from its mnemonic instead of generating a new one")
+
+	return cmd
+}
+
+func ListDidKeysCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the sovrin DIDs of the keyring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kb, err := ckeys.NewKeyBaseFromHomeFlag()
+			if err != nil {
+				return err
+			}
+
+			infos, err := kb.List()
+			if err != nil {
+				return err
+			}
+
+			didKeys := make([]DidKeyOutput, 0)
+			for _, info := range infos {
+				if didKey, ok := didKeyFromInfo(info); ok {
+					didKeys = append(didKeys, didKey)
+				}
+			}
+
+			return printOutput(didKeys)
+		},
+	}
+}
+
+func ShowDidKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [name]",
+		Short: "Show the DID and verify key of a sovrin DID of the keyring",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			didKey, err := getDidKey(args[0])
+			if err != nil {
+				return err
+			}
+
+			return printOutput(didKey)
+		},
+	}
+}
+
+func ExportDidKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [name]",
+		Short: "Print a sovrin DID of the keyring with its secrets, as taken by 'did keys import'",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetSovrinDidFromKeyring(args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(sovrinDid.String())
+			return nil
+		},
+	}
+}
+
+func ImportDidKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [name] [sovrin-did-file]",
+		Short: "Store the sovrin DID JSON of a file, such as one printed by 'did keys export', in the keyring",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			sovrinDid := sovrin.SovrinDid{}
+			if err := json.Unmarshal(bz, &sovrinDid); err != nil {
+				return err
+			}
+
+			// The stored key is derived from the seed, so the seed must match
+			// the rest of the DID
+			seed, err := hex.DecodeString(sovrinDid.Secret.Seed)
+			if err != nil || len(seed) != 32 {
+				return errors.New("sovrin DID must have a hex encoded 32 byte seed")
+			}
+			seed32 := [32]byte{}
+			copy(seed32[:], seed)
+			if derived := sovrin.FromSeed(seed32); derived.Did != sovrinDid.Did || derived.VerifyKey != sovrinDid.VerifyKey {
+				return errors.New("sovrin DID does not match its seed")
+			}
+
+			if err := storeSovrinDid(args[0], sovrinDid, bufio.NewReader(cmd.InOrStdin())); err != nil {
+				return err
+			}
+
+			return printOutput(newDidKeyOutput(args[0], sovrinDid.VerifyKey))
+		},
+	}
+}
+
+// FromDidCommands adds the required --from-did flag to ixo tx commands, which
+// are signed by the named sovrin DID of the keyring. Sovrin DIDs are never
+// passed as args, so that their secrets stay out of shell history. Tx
+// commands can instead be signed with the secp256k1 keyring key of --from,
+// if it is an authentication key of the DID.
+func FromDidCommands(cmds ...*cobra.Command) []*cobra.Command {
+	for _, cmd := range cmds {
+		cmd.Flags().String(FlagFromDid, "", "Name of the keyring sovrin DID that signs the tx")
+		_ = cmd.MarkFlagRequired(FlagFromDid)
+	}
+	return cmds
+}
+
+// GetFromDid returns the sovrin DID of the keyring named by --from-did. If the
+// tx is signed with the keyring key of --from, only the DID and verify key are
+// returned, without decrypting the secrets.
+func GetFromDid() (sovrin.SovrinDid, error) {
+	name := viper.GetString(FlagFromDid)
+	if name == "" {
+		return sovrin.SovrinDid{}, errors.New("you must provide --" + FlagFromDid)
+	}
+
+	if viper.GetString(flags.FlagFrom) != "" {
+		didKey, err := getDidKey(name)
+		if err != nil {
+			return sovrin.SovrinDid{}, err
+		}
+
+		return sovrin.SovrinDid{Did: didKey.Did, VerifyKey: didKey.VerifyKey}, nil
+	}
+
+	return GetSovrinDidFromKeyring(name)
+}
+
+// RequireSovrinSecret returns an error if the sovrin DID was returned without
+// its secrets, for commands that need more than a signature by the DID
+func RequireSovrinSecret(sovrinDid sovrin.SovrinDid) error {
+	if sovrinDid.Secret.SignKey == "" {
+		return errors.New("this command must be signed by the sovrin DID itself, without --" + flags.FlagFrom)
+	}
+	return nil
+}
+
+// SignIxoMsg signs the msg with the secp256k1 keyring key of --from, if set,
+// and otherwise with the sovrin DID. The key has to be an authentication key
+// of the signing DID. Ledger keys cannot be used, since the Ledger app only
+// signs standard cosmos txs.
+func SignIxoMsg(msg sdk.Msg, sovrinDid sovrin.SovrinDid) (ixo.IxoSignature, error) {
+	msgBytes, err := json.Marshal(msg)
+	if err != nil {
+		return ixo.IxoSignature{}, err
+	}
+
+	keyName := viper.GetString(flags.FlagFrom)
+	if keyName == "" {
+		privKey := [64]byte{}
+		copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
+		copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
+		return ixo.SignIxoMessage(msgBytes, sovrinDid.Did, privKey), nil
+	}
+
+	info, err := ckeys.GetKeyInfo(keyName)
+	if err != nil {
+		return ixo.IxoSignature{}, err
+	} else if info.GetType() != keys.TypeLocal {
+		return ixo.IxoSignature{}, fmt.Errorf("key %s is not a local key of the keyring", keyName)
+	} else if _, ok := info.GetPubKey().(secp256k1.PubKeySecp256k1); !ok {
+		return ixo.IxoSignature{}, fmt.Errorf("key %s is not a secp256k1 key", keyName)
+	}
+
+	kb, err := ckeys.NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return ixo.IxoSignature{}, err
+	}
+
+	passphrase, err := ckeys.GetPassphrase(keyName)
+	if err != nil {
+		return ixo.IxoSignature{}, err
+	}
+
+	return ixo.SignIxoMessageWithKeybase(msgBytes, kb, keyName, passphrase)
+}
+
+// GetSecp256k1PubKeyFromKeyring returns the base58 encoded public key of the
+// named secp256k1 key of the keyring, as added to DID docs
+func GetSecp256k1PubKeyFromKeyring(name string) (string, bool) {
+	info, err := ckeys.GetKeyInfo(name)
+	if err != nil {
+		return "", false
+	}
+
+	pubKey, ok := info.GetPubKey().(secp256k1.PubKeySecp256k1)
+	if !ok {
+		return "", false
+	}
+
+	return base58.Encode(pubKey[:]), true
+}
+
+// GetSovrinDidFromKeyring decrypts the named sovrin DID of the keyring,
+// prompting for its password
+func GetSovrinDidFromKeyring(name string) (sovrin.SovrinDid, error) {
+	if _, err := getDidKey(name); err != nil {
+		return sovrin.SovrinDid{}, err
+	}
+
+	kb, err := ckeys.NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return sovrin.SovrinDid{}, err
+	}
+
+	passphrase, err := ckeys.GetPassphrase(name)
+	if err != nil {
+		return sovrin.SovrinDid{}, err
+	}
+
+	privKey, err := kb.ExportPrivateKeyObject(name, passphrase)
+	if err != nil {
+		return sovrin.SovrinDid{}, err
+	}
+
+	ed25519PrivKey, ok := privKey.(ed25519.PrivKeyEd25519)
+	if !ok {
+		return sovrin.SovrinDid{}, fmt.Errorf("key %s is not a sovrin DID", name)
+	}
+
+	// An ed25519 private key is its seed followed by its public key
+	seed := [32]byte{}
+	copy(seed[:], ed25519PrivKey[:32])
+	return sovrin.FromSeed(seed), nil
+}
+
+func storeSovrinDid(name string, sovrinDid sovrin.SovrinDid, buf *bufio.Reader) error {
+	kb, err := ckeys.NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return err
+	}
+
+	if _, err := kb.Get(name); err == nil {
+		return fmt.Errorf("key %s already exists", name)
+	}
+
+	passphrase, err := input.GetCheckPassword(
+		"Enter a passphrase to encrypt your DID to disk:",
+		"Repeat the passphrase:", buf)
+	if err != nil {
+		return err
+	}
+
+	privKey := ed25519.PrivKeyEd25519{}
+	copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
+	copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
+
+	return kb.ImportPrivKey(name, mintkey.EncryptArmorPrivKey(privKey, passphrase), passphrase)
+}
+
+func getDidKey(name string) (DidKeyOutput, error) {
+	info, err := ckeys.GetKeyInfo(name)
+	if err != nil {
+		return DidKeyOutput{}, err
+	}
+
+	didKey, ok := didKeyFromInfo(info)
+	if !ok {
+		return DidKeyOutput{}, fmt.Errorf("key %s is not a sovrin DID", name)
+	}
+
+	return didKey, nil
+}
+
+// didKeyFromInfo returns the DID of a local ed25519 key of the keyring. Other
+// keys, such as the secp256k1 account keys, are not sovrin DIDs.
+func didKeyFromInfo(info keys.Info) (DidKeyOutput, bool) {
+	pubKey, ok := info.GetPubKey().(ed25519.PubKeyEd25519)
+	if !ok || info.GetType() != keys.TypeLocal {
+		return DidKeyOutput{}, false
+	}
+
+	return newDidKeyOutput(info.GetName(), base58.Encode(pubKey[:])), true
+}
+
+func newDidKeyOutput(name string, verifyKey string) DidKeyOutput {
+	did, _ := ixo.DidFromPubKey(verifyKey)
+	return DidKeyOutput{
+		Name:      name,
+		Did:       did,
+		VerifyKey: verifyKey,
+	}
+}
+
+func printOutput(output interface{}) error {
+	bz, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(bz))
+	return nil
+}
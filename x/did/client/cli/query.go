@@ -1,13 +1,12 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/ixofoundation/ixo-cosmos/x/did/internal/keeper"
 	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
@@ -20,7 +19,7 @@ func GetAddressFromDidCmd() *cobra.Command {
 		Short: "Query for an account address by DID",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			accAddress := sdk.AccAddress(crypto.AddressHash([]byte(args[0])))
+			accAddress := ixo.DidToAddr(args[0])
 			fmt.Println(accAddress.String())
 			return nil
 		},
@@ -68,26 +67,30 @@ func GetDidDocCmd(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
-func GetAllDidsCmd(cdc *codec.Codec) *cobra.Command {
+func GetDidDocumentCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "getAllDids",
-		Short: "Query all DIDs",
+		Use:   "getDidDocument [did]",
+		Short: "Query the W3C DID document for a DID",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide a did")
+			}
+
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
 			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
-				keeper.QueryAllDids, "ALL"), nil)
+				keeper.QueryDidDocument, args[0]), nil)
 			if err != nil {
 				return err
 			}
 
-			didDids := []ixo.Did{}
-			err = cdc.UnmarshalJSON(res, &didDids)
+			var didDocument types.DidDocument
+			err = json.Unmarshal(res, &didDocument)
 			if err != nil {
 				return err
 			}
 
-			output, err := cdc.MarshalJSONIndent(didDids, "", "  ")
+			output, err := json.MarshalIndent(didDocument, "", "  ")
 			if err != nil {
 				return err
 			}
@@ -98,26 +101,222 @@ func GetAllDidsCmd(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetAllDidsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "getAllDids",
+		Short: "Query a page of DIDs, optionally filtered by credential type and creation height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			params := queryDidsParamsFromFlags()
+			if err := params.ValidatePagination(); err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+				keeper.QueryDids), bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDidsResult
+			err = json.Unmarshal(res, &result)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	addQueryDidsFlags(cmd, true)
+	return cmd
+}
+
 func GetAllDidDocsCmd(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "getAllDidDocs",
-		Short: "Query all DID documents",
+		Short: "Query a page of DID documents, optionally filtered by credential type and creation height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			params := queryDidsParamsFromFlags()
+			if err := params.ValidatePagination(); err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+				keeper.QueryDidDocs), bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDidDocsResult
+			err = json.Unmarshal(res, &result)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	addQueryDidsFlags(cmd, true)
+	return cmd
+}
+
+func GetDidCountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "getDidCount",
+		Short: "Query the number of DIDs, optionally filtered by credential type and creation height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := json.Marshal(queryDidsParamsFromFlags())
+			if err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+				keeper.QueryDidCount), bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDidCountResult
+			err = json.Unmarshal(res, &result)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(result.Count)
+			return nil
+		},
+	}
+
+	addQueryDidsFlags(cmd, false)
+	return cmd
+}
+
+func GetDidMigrationReportCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getDidMigrationReport",
+		Short: "List the DID documents whose DID is not derived from their public key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+				keeper.QueryDidMigrationReport), nil)
+			if err != nil {
+				return err
+			}
+
+			var report types.DidMigrationReport
+			err = cdc.UnmarshalJSON(res, &report)
+			if err != nil {
+				return err
+			}
+
+			output, err := cdc.MarshalJSONIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCredentialCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getCredential [hash]",
+		Short: "Query an anchored verifiable credential by its hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryCredential, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var credential types.AnchoredCredential
+			err = json.Unmarshal(res, &credential)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(credential, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetCredentialsBySubjectCmd(cdc *codec.Codec) *cobra.Command {
+	return getCredentialsCmd(cdc, "getCredentialsBySubject [did]",
+		"Query the anchored verifiable credentials about a subject DID", keeper.QueryCredentialsBySubject)
+}
+
+func GetCredentialsByIssuerCmd(cdc *codec.Codec) *cobra.Command {
+	return getCredentialsCmd(cdc, "getCredentialsByIssuer [did]",
+		"Query the anchored verifiable credentials issued by a DID", keeper.QueryCredentialsByIssuer)
+}
+
+func GetCredentialsByTypeCmd(cdc *codec.Codec) *cobra.Command {
+	return getCredentialsCmd(cdc, "getCredentialsByType [type]",
+		"Query the anchored verifiable credentials of a type, e.g. AccreditedInvestorCredential", keeper.QueryCredentialsByType)
+}
+
+func getCredentialsCmd(cdc *codec.Codec, use string, short string, queryRoute string) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 
 			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
-				keeper.QueryAllDidDocs, "ALL"), nil)
+				queryRoute, args[0]), nil)
 			if err != nil {
 				return err
 			}
 
-			var didDocs []types.BaseDidDoc
-			err = cdc.UnmarshalJSON(res, &didDocs)
+			var credentials []types.AnchoredCredential
+			err = json.Unmarshal(res, &credentials)
 			if err != nil {
 				return err
 			}
 
-			output, err := cdc.MarshalJSONIndent(didDocs, "", "  ")
+			output, err := json.MarshalIndent(credentials, "", "  ")
 			if err != nil {
 				return err
 			}
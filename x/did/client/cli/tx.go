@@ -3,14 +3,16 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
-	"github.com/btcsuite/btcutil/base58"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ixofoundation/ixo-cosmos/x/did/internal/keeper"
 	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
@@ -18,35 +20,52 @@ import (
 	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
 )
 
+func IxoSignAndBroadcast(cdc *codec.Codec, ctx context.CLIContext, msg sdk.Msg, sovrinDid sovrin.SovrinDid) error {
+	signature, err := SignIxoMsg(msg, sovrinDid)
+	if err != nil {
+		return err
+	}
+
+	tx := ixo.NewIxoTxSingleMsg(msg, signature)
+
+	bz, err := cdc.MarshalJSON(tx)
+	if err != nil {
+		return err
+	}
+
+	res, err := ctx.BroadcastTx(bz)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(res.String())
+	fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.TxHash)
+	return nil
+}
+
 func AddDidDocCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "addDidDoc [sovrin-did]",
-		Short: "Add a new SovrinDid",
+		Use:   "addDidDoc",
+		Short: "Add the DID doc of a sovrin DID stored with 'did keys add' or 'did keys import'",
+		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 1 || len(args[0]) == 0 {
-				return errors.New("You must provide the sovrin did document as generated by 'sovrin-did' node package")
-			}
-
-			sovrinDid := sovrin.SovrinDid{}
-			err := json.Unmarshal([]byte(args[0]), &sovrinDid)
+			sovrinDid, err := GetFromDid()
 			if err != nil {
 				return err
+			} else if err := RequireSovrinSecret(sovrinDid); err != nil {
+				return err
 			}
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
 			msg := types.NewAddDidMsg(sovrinDid.Did, sovrinDid.VerifyKey)
+			msg.DidDoc.KeyAgreementKey = sovrinDid.EncryptionPublicKey
 
-			privKey := [64]byte{}
-			copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
-			copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
-
-			msgBytes, err := json.Marshal(msg)
+			signature, err := SignIxoMsg(msg, sovrinDid)
 			if err != nil {
 				return err
 			}
 
-			signature := ixo.SignIxoMessage(msgBytes, sovrinDid.Did, privKey)
 			tx := ixo.NewIxoTxSingleMsg(msg, signature)
 
 			bz, err := cdc.MarshalJSON(tx)
@@ -69,11 +88,12 @@ func AddDidDocCmd(cdc *codec.Codec) *cobra.Command {
 
 func AddCredentialCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "addKycCredential [did] [signer-did-doc]",
+		Use:   "addKycCredential [did]",
 		Short: "Add a new KYC Credential for a Did by the signer",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
-				return errors.New("You must provide a did and the signer's sovrin didDoc")
+			if len(args[0]) == 0 {
+				return errors.New("You must provide a did")
 			}
 
 			didAddr := args[0]
@@ -86,10 +106,9 @@ func AddCredentialCmd(cdc *codec.Codec) *cobra.Command {
 				return errors.New("The did is not on the blockchain")
 			}
 
-			sovrinDid := sovrin.SovrinDid{}
-			err = json.Unmarshal([]byte(args[1]), &sovrinDid)
+			sovrinDid, err := GetFromDid()
 			if err != nil {
-				panic(err)
+				return err
 			}
 
 			t := time.Now()
@@ -99,16 +118,11 @@ func AddCredentialCmd(cdc *codec.Codec) *cobra.Command {
 
 			msg := types.NewAddCredentialMsg(didAddr, credTypes, sovrinDid.Did, issued)
 
-			privKey := [64]byte{}
-			copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
-			copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
-
-			msgBytes, err := json.Marshal(msg)
+			signature, err := SignIxoMsg(msg, sovrinDid)
 			if err != nil {
-				panic(err)
+				return err
 			}
 
-			signature := ixo.SignIxoMessage(msgBytes, sovrinDid.Did, privKey)
 			tx := ixo.NewIxoTxSingleMsg(msg, signature)
 
 			bz, err := cdc.MarshalJSON(tx)
@@ -127,3 +141,347 @@ func AddCredentialCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func AddServiceCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "addService [did] [service-id] [type] [service-endpoint]",
+		Short: "Add a service endpoint to a DID document, signed by one of its authentication keys",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			msg := types.NewAddServiceMsg(args[0], types.Service{
+				Id:              args[1],
+				Type:            args[2],
+				ServiceEndpoint: args[3],
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+}
+
+func RemoveServiceCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "removeService [did] [service-id]",
+		Short: "Remove a service endpoint from a DID document",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			msg := types.NewRemoveServiceMsg(args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+}
+
+func AddVerificationKeyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addVerificationKey [did] [key-id] [pub-key] [purposes]",
+		Short: "Add a base58 encoded key to a DID document, with comma-separated purposes (authentication, assertionMethod)",
+		Long: `Add a base58 encoded key to a DID document, with comma-separated purposes (authentication, assertionMethod).
+The pub-key can also be the name of a secp256k1 key of the keyring, which can then sign txs for the DID with --from.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			var purposes []types.KeyPurpose
+			for _, purpose := range strings.Split(args[3], ",") {
+				purposes = append(purposes, types.KeyPurpose(strings.TrimSpace(purpose)))
+			}
+
+			pubKey, keyType := args[2], viper.GetString(FlagKeyType)
+			if keyringPubKey, ok := GetSecp256k1PubKeyFromKeyring(args[2]); ok {
+				pubKey, keyType = keyringPubKey, types.Secp256k1VerificationKey2019
+			}
+
+			msg := types.NewAddVerificationKeyMsg(args[0], types.VerificationKey{
+				Id:       args[1],
+				PubKey:   pubKey,
+				Purposes: purposes,
+				Type:     keyType,
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+
+	cmd.Flags().String(FlagKeyType, types.Ed25519VerificationKey2018,
+		fmt.Sprintf("Type of the key (%s, %s)", types.Ed25519VerificationKey2018, types.Secp256k1VerificationKey2019))
+
+	return cmd
+}
+
+func RemoveVerificationKeyCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "removeVerificationKey [did] [key-id]",
+		Short: "Remove an additional key from a DID document",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			msg := types.NewRemoveVerificationKeyMsg(args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+}
+
+const (
+	FlagStoreCredential = "store-credential"
+	FlagExpiry          = "expiry"
+	FlagKeyType         = "key-type"
+)
+
+func AddVerifiableCredentialCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addVerifiableCredential [credential-json]",
+		Short: "Anchor a W3C Verifiable Credential, adding an IxoEd25519Signature2020 proof by the issuer if it has none",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			credentialJson, err := addCredentialProof(args[0], sovrinDid)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewAddVerifiableCredentialMsg(sovrinDid.Did, credentialJson,
+				viper.GetBool(FlagStoreCredential))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+
+	cmd.Flags().Bool(FlagStoreCredential, false, "Store the full credential on chain instead of only its hash and metadata")
+
+	return cmd
+}
+
+// addCredentialProof signs the credential with the issuer's key-1 unless it
+// already has a proof
+func addCredentialProof(credentialJson string, sovrinDid sovrin.SovrinDid) (string, error) {
+	var credential map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(credentialJson))
+	decoder.UseNumber()
+	if err := decoder.Decode(&credential); err != nil {
+		return "", err
+	}
+
+	if _, ok := credential["proof"]; ok {
+		return credentialJson, nil
+	} else if err := RequireSovrinSecret(sovrinDid); err != nil {
+		return "", err
+	}
+
+	canonical, err := types.CanonicalCredentialBytes(credentialJson)
+	if err != nil {
+		return "", err
+	}
+
+	proof := types.CredentialProof{
+		Type:               types.IxoEd25519Signature2020,
+		Created:            time.Now().UTC().Format(time.RFC3339),
+		VerificationMethod: ixo.PrefixedDid(sovrinDid.Did) + "#" + types.PrimaryKeyId,
+		ProofPurpose:       types.AssertionProofPurpose,
+	}
+	proof.Jws = types.SignCredentialJws(proof, canonical, sovrinDid.Secret.SignKey, sovrinDid.VerifyKey)
+	credential["proof"] = proof
+
+	bz, err := json.Marshal(credential)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+func AddControllerCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "addController [did] [controller-did]",
+		Short: "Let the controller DID sign any msg on behalf of a DID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			msg := types.NewAddControllerMsg(args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+}
+
+func RemoveControllerCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "removeController [did] [controller-did]",
+		Short: "Remove a controller of a DID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			msg := types.NewRemoveControllerMsg(args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+}
+
+func SetDelegationCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setDelegation [did] [delegate-did] [msg-types]",
+		Short: "Let a delegate DID sign comma-separated msg types (e.g. project/CreateClaimMsg or project/*) on behalf of a DID",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			var expiry time.Time
+			if expiryStr := viper.GetString(FlagExpiry); expiryStr != "" {
+				var err error
+				expiry, err = time.Parse(time.RFC3339, expiryStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			var msgTypes []string
+			for _, msgType := range strings.Split(args[2], ",") {
+				msgTypes = append(msgTypes, strings.TrimSpace(msgType))
+			}
+
+			msg := types.NewSetDelegationMsg(args[0], types.Delegation{
+				DelegateDid: args[1],
+				MsgTypes:    msgTypes,
+				Expiry:      expiry.UTC(),
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiry, "", "RFC3339 time at which the delegation expires (default never)")
+
+	return cmd
+}
+
+func RemoveDelegationCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "removeDelegation [did] [delegate-did]",
+		Short: "Remove the delegation of a DID to a delegate DID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			msg := types.NewRemoveDelegationMsg(args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+}
+
+func SetKeyAgreementKeyCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "setKeyAgreementKey",
+		Short: "Publish the encryption public key of a sovrin DID as the key agreement key of its DID doc",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sovrinDid, err := GetFromDid()
+			if err != nil {
+				return err
+			} else if err := RequireSovrinSecret(sovrinDid); err != nil {
+				return err
+			}
+
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			msg := types.NewSetKeyAgreementKeyMsg(sovrinDid.Did, sovrinDid.EncryptionPublicKey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+}
@@ -1,10 +1,11 @@
 package rest
 
 import (
+	"encoding/json"
 	"fmt"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/tendermint/tendermint/crypto"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/gorilla/mux"
@@ -18,8 +19,15 @@ import (
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/didToAddr/{did}", queryAddressFromDidRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/did/{did}", queryDidDocRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/1.0/identifiers/{did}", resolveDidRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/did", queryAllDidsRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/allDidDocs", queryAllDidDocsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didCount", queryDidCountRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/didMigrationReport", queryDidMigrationReportRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/credential/{hash}", queryCredentialRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/credentials/subject/{did}", queryCredentialsRequestHandler(cliCtx, keeper.QueryCredentialsBySubject, "did")).Methods("GET")
+	r.HandleFunc("/credentials/issuer/{did}", queryCredentialsRequestHandler(cliCtx, keeper.QueryCredentialsByIssuer, "did")).Methods("GET")
+	r.HandleFunc("/credentials/type/{type}", queryCredentialsRequestHandler(cliCtx, keeper.QueryCredentialsByType, "type")).Methods("GET")
 }
 
 func queryAddressFromDidRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -27,7 +35,7 @@ func queryAddressFromDidRequestHandler(cliCtx context.CLIContext) http.HandlerFu
 
 		w.Header().Set("Content-Type", "application/json")
 		vars := mux.Vars(r)
-		accAddress := sdk.AccAddress(crypto.AddressHash([]byte(vars["did"])))
+		accAddress := ixo.DidToAddr(vars["did"])
 
 		rest.PostProcessResponse(w, cliCtx.Codec, accAddress, true)
 	}
@@ -62,12 +70,103 @@ func queryDidDocRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// resolveDidRequestHandler serves did:ixo DID documents in the format of a
+// Universal Resolver driver
+func resolveDidRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", types.DidResolutionContentType)
+		did := mux.Vars(r)["did"]
+
+		if !strings.HasPrefix(did, ixo.DidPrefixIxo) {
+			writeDidResolutionError(w, http.StatusBadRequest, "invalidDid")
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryDidDocument, did), nil)
+		if err != nil {
+			writeDidResolutionError(w, http.StatusNotFound, "notFound")
+			return
+		}
+
+		var didDocument types.DidDocument
+		if err := json.Unmarshal(res, &didDocument); err != nil {
+			writeDidResolutionError(w, http.StatusInternalServerError, "internalError")
+			return
+		}
+
+		bz, _ := json.Marshal(types.NewDidResolutionResult(didDocument))
+		_, _ = w.Write(bz)
+	}
+}
+
+func writeDidResolutionError(w http.ResponseWriter, status int, resolutionError string) {
+	result := types.DidResolutionResult{
+		Context:               types.DidResolutionContext,
+		DidResolutionMetadata: types.DidResolutionMetadata{Error: resolutionError},
+	}
+
+	w.WriteHeader(status)
+	bz, _ := json.Marshal(result)
+	_, _ = w.Write(bz)
+}
+
+// parseQueryDidsParams reads the credentialType, createdAfter, after, page
+// and limit query parameters
+func parseQueryDidsParams(r *http.Request) (types.QueryDidsParams, error) {
+	query := r.URL.Query()
+
+	var createdAfter int64
+	page, limit := uint64(1), types.DefaultDidsPerPage
+	var err error
+	if createdAfterStr := query.Get("createdAfter"); createdAfterStr != "" {
+		if createdAfter, err = strconv.ParseInt(createdAfterStr, 10, 64); err != nil {
+			return types.QueryDidsParams{}, fmt.Errorf("invalid createdAfter: %s", err)
+		}
+	}
+	if pageStr := query.Get("page"); pageStr != "" {
+		if page, err = strconv.ParseUint(pageStr, 10, 64); err != nil {
+			return types.QueryDidsParams{}, fmt.Errorf("invalid page: %s", err)
+		}
+	}
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if limit, err = strconv.ParseUint(limitStr, 10, 64); err != nil {
+			return types.QueryDidsParams{}, fmt.Errorf("invalid limit: %s", err)
+		}
+	}
+
+	params := types.NewQueryDidsParams(query.Get("credentialType"), createdAfter,
+		query.Get("after"), page, limit)
+	if err := params.ValidateFilters(); err != nil {
+		return params, err
+	}
+
+	return params, params.ValidatePagination()
+}
+
 func queryAllDidsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
 		w.Header().Set("Content-Type", "application/json")
+		params, err := parseQueryDidsParams(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		bz, err := json.Marshal(params)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
 		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
-			keeper.QueryAllDids), nil)
+			keeper.QueryDids), bz)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did. Error: %s", err.Error())))
@@ -75,41 +174,144 @@ func queryAllDidsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		if len(res) == 0 {
-			w.WriteHeader(http.StatusNoContent)
+		rest.PostProcessResponse(w, cliCtx.Codec, res, true)
+	}
+}
+
+func queryAllDidDocsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		params, err := parseQueryDidsParams(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		bz, err := json.Marshal(params)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+
 			return
 		}
 
-		dids := []ixo.Did{}
-		cliCtx.Codec.MustUnmarshalJSON(res, &dids)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+			keeper.QueryDidDocs), bz)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did. Error: %s", err.Error())))
 
-		rest.PostProcessResponse(w, cliCtx.Codec, dids, true)
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx.Codec, res, true)
 	}
 }
 
-func queryAllDidDocsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+func queryDidCountRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
 		w.Header().Set("Content-Type", "application/json")
+		params, err := parseQueryDidsParams(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		bz, err := json.Marshal(params)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
 		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
-			keeper.QueryAllDidDocs), nil)
+			keeper.QueryDidCount), bz)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
-			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did. Error: %s", err.Error())))
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did count. Error: %s", err.Error())))
 
 			return
 		}
 
-		if len(res) == 0 {
-			w.WriteHeader(http.StatusNoContent)
-			_, _ = w.Write([]byte("No data present."))
+		rest.PostProcessResponse(w, cliCtx.Codec, res, true)
+	}
+}
+
+func queryDidMigrationReportRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+			keeper.QueryDidMigrationReport), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query did migration report. Error: %s", err.Error())))
+
+			return
+		}
+
+		var report types.DidMigrationReport
+		cliCtx.Codec.MustUnmarshalJSON(res, &report)
+
+		rest.PostProcessResponse(w, cliCtx.Codec, report, true)
+	}
+}
+
+func queryCredentialRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryCredential, vars["hash"]), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query credential. Error: %s", err.Error())))
+
+			return
+		}
+
+		var credential types.AnchoredCredential
+		if err := json.Unmarshal(res, &credential); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx.Codec, credential, true)
+	}
+}
+
+func queryCredentialsRequestHandler(cliCtx context.CLIContext, queryRoute string, param string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			queryRoute, vars[param]), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query credentials. Error: %s", err.Error())))
 
 			return
 		}
 
-		didDocs := []types.BaseDidDoc{}
-		cliCtx.Codec.MustUnmarshalJSON(res, &didDocs)
+		credentials := []types.AnchoredCredential{}
+		if err := json.Unmarshal(res, &credentials); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
 
-		rest.PostProcessResponse(w, cliCtx.Codec, didDocs, true)
+		rest.PostProcessResponse(w, cliCtx.Codec, credentials, true)
 	}
 }
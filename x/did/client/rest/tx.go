@@ -38,6 +38,7 @@ func createDidRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 		
 		msg := types.NewAddDidMsg(sovrinDid.Did, sovrinDid.VerifyKey)
+		msg.DidDoc.KeyAgreementKey = sovrinDid.EncryptionPublicKey
 		privKey := [64]byte{}
 		copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
 		copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
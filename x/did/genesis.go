@@ -6,6 +6,7 @@ import (
 )
 
 func InitGenesis(ctx types.Context, keeper Keeper, data GenesisState) []abciTypes.ValidatorUpdate {
+	keeper.SetStoreVersion(ctx, StoreVersion)
 	return []abciTypes.ValidatorUpdate{}
 }
 
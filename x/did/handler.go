@@ -14,6 +14,26 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleAddDidDocMsg(ctx, k, msg)
 		case types.AddCredentialMsg:
 			return handleAddCredentialMsg(ctx, k, msg)
+		case types.AddServiceMsg:
+			return handleDidDocUpdate(k.AddService(ctx, msg.Did, msg.Service))
+		case types.RemoveServiceMsg:
+			return handleDidDocUpdate(k.RemoveService(ctx, msg.Did, msg.ServiceId))
+		case types.AddVerificationKeyMsg:
+			return handleDidDocUpdate(k.AddVerificationKey(ctx, msg.Did, msg.VerificationKey))
+		case types.RemoveVerificationKeyMsg:
+			return handleDidDocUpdate(k.RemoveVerificationKey(ctx, msg.Did, msg.KeyId))
+		case types.AddControllerMsg:
+			return handleDidDocUpdate(k.AddController(ctx, msg.Did, msg.ControllerDid))
+		case types.RemoveControllerMsg:
+			return handleDidDocUpdate(k.RemoveController(ctx, msg.Did, msg.ControllerDid))
+		case types.SetDelegationMsg:
+			return handleDidDocUpdate(k.SetDelegation(ctx, msg.Did, msg.Delegation))
+		case types.RemoveDelegationMsg:
+			return handleDidDocUpdate(k.RemoveDelegation(ctx, msg.Did, msg.DelegateDid))
+		case types.SetKeyAgreementKeyMsg:
+			return handleDidDocUpdate(k.SetKeyAgreementKey(ctx, msg.Did, msg.KeyAgreementKey))
+		case types.AddVerifiableCredentialMsg:
+			return handleAddVerifiableCredentialMsg(ctx, k, msg)
 		default:
 			return sdk.ErrUnknownRequest("No match for message type.").Result()
 		}
@@ -47,3 +67,25 @@ func handleAddCredentialMsg(ctx sdk.Context, k keeper.Keeper, msg types.AddCrede
 		Code: sdk.CodeOK,
 	}
 }
+
+func handleAddVerifiableCredentialMsg(ctx sdk.Context, k keeper.Keeper, msg types.AddVerifiableCredentialMsg) sdk.Result {
+	credential, err := k.AddVerifiableCredential(ctx, msg.Credential, msg.StoreCredential)
+	if err != nil {
+		return err.Result()
+	}
+	
+	return sdk.Result{
+		Code: sdk.CodeOK,
+		Data: []byte(credential.Hash),
+	}
+}
+
+func handleDidDocUpdate(err sdk.Error) sdk.Result {
+	if err != nil {
+		return err.Result()
+	}
+	
+	return sdk.Result{
+		Code: sdk.CodeOK,
+	}
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+func (k Keeper) GetCredential(ctx sdk.Context, hash string) (types.AnchoredCredential, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCredentialKey(hash))
+	if bz == nil {
+		return types.AnchoredCredential{}, types.ErrorInvalidCredentials(types.DefaultCodeSpace,
+			fmt.Sprintf("credential %s does not exist", hash))
+	}
+	
+	var credential types.AnchoredCredential
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &credential)
+	
+	return credential, nil
+}
+
+// AddVerifiableCredential checks the proof of the credential against the
+// issuer's DID doc, and anchors the credential under its hash
+func (k Keeper) AddVerifiableCredential(ctx sdk.Context, credentialJson string,
+	storeCredential bool) (types.AnchoredCredential, sdk.Error) {
+	
+	credential, canonical, err := types.ParseVerifiableCredential(credentialJson)
+	if err != nil {
+		return types.AnchoredCredential{}, err
+	}
+	
+	if _, err := k.GetCredential(ctx, credential.Hash); err == nil {
+		return types.AnchoredCredential{}, types.ErrorInvalidCredentials(types.DefaultCodeSpace,
+			"credential already exists")
+	}
+	
+	if err := k.verifyCredentialProof(ctx, credential, canonical); err != nil {
+		return types.AnchoredCredential{}, err
+	}
+	
+	if storeCredential {
+		credential.Credential = credentialJson
+	}
+	k.SetCredential(ctx, credential)
+	
+	return credential, nil
+}
+
+func (k Keeper) verifyCredentialProof(ctx sdk.Context, credential types.AnchoredCredential, canonical []byte) sdk.Error {
+	proof := credential.Proof
+	if proof.Type != types.IxoEd25519Signature2020 {
+		return types.ErrorInvalidCredentials(types.DefaultCodeSpace, "proof type must be IxoEd25519Signature2020")
+	} else if proof.ProofPurpose != types.AssertionProofPurpose {
+		return types.ErrorInvalidCredentials(types.DefaultCodeSpace, "proof purpose must be assertionMethod")
+	}
+	
+	did, keyId, ok := types.SplitVerificationMethod(proof.VerificationMethod)
+	if !ok || ixo.UnprefixedDid(did) != ixo.UnprefixedDid(credential.Issuer) {
+		return types.ErrorInvalidCredentials(types.DefaultCodeSpace, "proof must be made with a key of the issuer")
+	}
+	
+	issuerDidDoc, err := k.ResolveDidDoc(ctx, credential.Issuer)
+	if err != nil {
+		return types.ErrorInvalidIssuer(types.DefaultCodeSpace, "issuer did not found")
+	}
+	
+	pubKey, ok := issuerDidDoc.(types.BaseDidDoc).GetAssertionKey(keyId)
+	if !ok {
+		return types.ErrorInvalidCredentials(types.DefaultCodeSpace,
+			fmt.Sprintf("issuer has no assertion key %s", keyId))
+	} else if !types.VerifyCredentialJws(proof, canonical, pubKey) {
+		return types.ErrorInvalidCredentials(types.DefaultCodeSpace, "invalid credential proof")
+	}
+	
+	return nil
+}
+
+func (k Keeper) SetCredential(ctx sdk.Context, credential types.AnchoredCredential) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCredentialKey(credential.Hash), k.cdc.MustMarshalBinaryLengthPrefixed(credential))
+	store.Set(types.GetCredentialSubjectIndexKey(credential.Subject, credential.Hash), []byte{})
+	store.Set(types.GetCredentialIssuerIndexKey(credential.Issuer, credential.Hash), []byte{})
+	for _, credType := range credential.Types {
+		store.Set(types.GetCredentialTypeIndexKey(credType, credential.Hash), []byte{})
+	}
+}
+
+func (k Keeper) GetCredentialsBySubject(ctx sdk.Context, subject ixo.Did) []types.AnchoredCredential {
+	return k.getCredentialsByIndex(ctx, types.GetCredentialSubjectPrefixKey(subject))
+}
+
+func (k Keeper) GetCredentialsByIssuer(ctx sdk.Context, issuer ixo.Did) []types.AnchoredCredential {
+	return k.getCredentialsByIndex(ctx, types.GetCredentialIssuerPrefixKey(issuer))
+}
+
+func (k Keeper) GetCredentialsByType(ctx sdk.Context, credType string) []types.AnchoredCredential {
+	return k.getCredentialsByIndex(ctx, types.GetCredentialTypePrefixKey(credType))
+}
+
+func (k Keeper) getCredentialsByIndex(ctx sdk.Context, prefix []byte) []types.AnchoredCredential {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	
+	credentials := []types.AnchoredCredential{}
+	for ; iterator.Valid(); iterator.Next() {
+		credential, err := k.GetCredential(ctx, string(iterator.Key()[len(prefix):]))
+		if err != nil {
+			continue
+		}
+		credentials = append(credentials, credential)
+	}
+	
+	return credentials
+}
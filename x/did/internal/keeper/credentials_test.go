@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+)
+
+func signCredential(t *testing.T, credential map[string]interface{}, issuer sovrin.SovrinDid) string {
+	bz, err := json.Marshal(credential)
+	require.Nil(t, err)
+	canonical, err := types.CanonicalCredentialBytes(string(bz))
+	require.Nil(t, err)
+	
+	proof := types.CredentialProof{
+		Type:               types.IxoEd25519Signature2020,
+		Created:            "2020-06-01T00:00:00Z",
+		VerificationMethod: "did:ixo:" + issuer.Did + "#key-1",
+		ProofPurpose:       types.AssertionProofPurpose,
+	}
+	proof.Jws = types.SignCredentialJws(proof, canonical, issuer.Secret.SignKey, issuer.VerifyKey)
+	credential["proof"] = proof
+	bz, err = json.Marshal(credential)
+	require.Nil(t, err)
+	
+	return string(bz)
+}
+
+func TestKeeperVerifiableCredentials(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	
+	issuer := sovrin.Gen()
+	k.AddDidDoc(ctx, types.InitDidDoc(issuer.Did, issuer.VerifyKey))
+	
+	newCredential := func() map[string]interface{} {
+		return map[string]interface{}{
+			"@context":     []string{types.CredentialContextW3C},
+			"type":         []string{types.VerifiableCredentialType, "CertifiedEvaluatorCredential"},
+			"issuer":       "did:ixo:" + issuer.Did,
+			"issuanceDate": "2020-06-01T00:00:00Z",
+			"credentialSubject": map[string]interface{}{
+				"id":     "did:ixo:" + types.ValidDidDoc.Did,
+				"domain": "water",
+			},
+		}
+	}
+	
+	credentialJson := signCredential(t, newCredential(), issuer)
+	credential, err := k.AddVerifiableCredential(ctx, credentialJson, false)
+	require.Nil(t, err)
+	require.Empty(t, credential.Credential)
+	
+	// The same credential cannot be anchored twice
+	_, err = k.AddVerifiableCredential(ctx, credentialJson, true)
+	require.NotNil(t, err)
+	
+	stored, err := k.GetCredential(ctx, credential.Hash)
+	require.Nil(t, err)
+	require.Equal(t, credential, stored)
+	require.Equal(t, []types.AnchoredCredential{credential},
+		k.GetCredentialsBySubject(ctx, "did:ixo:"+types.ValidDidDoc.Did))
+	require.Equal(t, []types.AnchoredCredential{credential},
+		k.GetCredentialsByIssuer(ctx, "did:ixo:"+issuer.Did))
+	require.Equal(t, []types.AnchoredCredential{credential},
+		k.GetCredentialsByType(ctx, "CertifiedEvaluatorCredential"))
+	require.Empty(t, k.GetCredentialsByType(ctx, "AccreditedInvestorCredential"))
+	
+	// A credential changed after signing is rejected
+	var tampered map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(signCredential(t, newCredential(), issuer)), &tampered))
+	tampered["credentialSubject"].(map[string]interface{})["domain"] = "energy"
+	tamperedJson, _ := json.Marshal(tampered)
+	_, err = k.AddVerifiableCredential(ctx, string(tamperedJson), false)
+	require.NotNil(t, err)
+	
+	// So is a credential signed by someone other than the issuer
+	other := sovrin.Gen()
+	k.AddDidDoc(ctx, types.InitDidDoc(other.Did, other.VerifyKey))
+	forged := newCredential()
+	forged["issuanceDate"] = "2020-07-01T00:00:00Z"
+	forgedJson := signCredential(t, forged, other)
+	_, err = k.AddVerifiableCredential(ctx, forgedJson, false)
+	require.NotNil(t, err)
+	
+	// The proof options are signed too
+	var backdated map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(signCredential(t, newCredential(), issuer)), &backdated))
+	backdated["proof"].(map[string]interface{})["created"] = "2019-01-01T00:00:00Z"
+	backdatedJson, _ := json.Marshal(backdated)
+	_, err = k.AddVerifiableCredential(ctx, string(backdatedJson), false)
+	require.NotNil(t, err)
+}
+
+func TestKeeperCredentialIndexValuesWithSlashes(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	
+	issuer := sovrin.Gen()
+	k.AddDidDoc(ctx, types.InitDidDoc(issuer.Did, issuer.VerifyKey))
+	
+	credentialJson := signCredential(t, map[string]interface{}{
+		"type":         []string{types.VerifiableCredentialType, "Evaluator/Water"},
+		"issuer":       "did:ixo:" + issuer.Did,
+		"issuanceDate": "2020-06-01T00:00:00Z",
+		"credentialSubject": map[string]interface{}{
+			"id": "did:ixo:" + types.ValidDidDoc.Did,
+		},
+	}, issuer)
+	credential, err := k.AddVerifiableCredential(ctx, credentialJson, false)
+	require.Nil(t, err)
+	
+	require.Equal(t, []types.AnchoredCredential{credential}, k.GetCredentialsByType(ctx, "Evaluator/Water"))
+	require.Empty(t, k.GetCredentialsByType(ctx, "Evaluator"))
+	
+	// Index keys that do not end in a credential hash are skipped
+	ctx.KVStore(k.storeKey).Set(types.GetCredentialTypeIndexKey("Evaluator/Water", "not-a-hash"), []byte{})
+	require.Equal(t, []types.AnchoredCredential{credential}, k.GetCredentialsByType(ctx, "Evaluator/Water"))
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"encoding/binary"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+// GetDidCreatedHeight returns the block height at which the DID was created,
+// or 0 for DIDs created before creation heights were recorded
+func (k Keeper) GetDidCreatedHeight(ctx sdk.Context, did ixo.Did) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDidCreatedHeightKey(did))
+	if bz == nil {
+		return 0
+	}
+	
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k Keeper) setDidCreatedHeight(ctx sdk.Context, did ixo.Did, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDidCreatedHeightKey(did), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetDidCount returns the number of DID docs, counted when they are created
+func (k Keeper) GetDidCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DidCountKey)
+	if bz == nil {
+		return 0
+	}
+	
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setDidCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DidCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// GetDidDocs returns the requested page of the DID docs matching the params,
+// and the DID to continue after if there are more. Only the DID docs of the
+// page are unmarshalled, unless the criteria need the docs to be read, but
+// the matching DIDs of the pages before it are still scanned, so the After
+// cursor should be preferred to deep pages.
+func (k Keeper) GetDidDocs(ctx sdk.Context, params types.QueryDidsParams) (
+	didDocs []types.BaseDidDoc, nextKey ixo.Did) {
+	
+	start, end := ixo.PageBounds(params.Page, params.Limit)
+	if start == end {
+		return nil, ""
+	}
+	
+	var matched uint64
+	k.iterateMatchingDidDocs(ctx, params, func(did ixo.Did, getDidDoc func() types.BaseDidDoc) bool {
+		if matched >= end {
+			nextKey = didDocs[len(didDocs)-1].Did
+			return true
+		}
+		if matched >= start {
+			didDocs = append(didDocs, getDidDoc())
+		}
+		matched++
+		return false
+	})
+	
+	return didDocs, nextKey
+}
+
+// CountDidDocs returns the number of DID docs matching the params, ignoring
+// pagination. Without criteria it is the stored DID count.
+func (k Keeper) CountDidDocs(ctx sdk.Context, params types.QueryDidsParams) (count uint64) {
+	if params.CredentialType == "" && params.CreatedAfter == 0 && params.After == "" {
+		return k.GetDidCount(ctx)
+	}
+	
+	k.iterateMatchingDidDocs(ctx, params, func(ixo.Did, func() types.BaseDidDoc) bool {
+		count++
+		return false
+	})
+	
+	return count
+}
+
+// iterateMatchingDidDocs calls cb, in DID order, with each DID after
+// params.After that matches the criteria and a function that returns its DID
+// doc, until cb returns true. DID docs are only unmarshalled when needed.
+func (k Keeper) iterateMatchingDidDocs(ctx sdk.Context, params types.QueryDidsParams,
+	cb func(did ixo.Did, getDidDoc func() types.BaseDidDoc) (stop bool)) {
+	
+	// DIDs that are the subject of an anchored credential of the type, which
+	// may be stored with or without their method prefix
+	var credentialSubjects map[ixo.Did]bool
+	if params.CredentialType != "" {
+		credentialSubjects = make(map[ixo.Did]bool)
+		for _, credential := range k.GetCredentialsByType(ctx, params.CredentialType) {
+			credentialSubjects[ixo.UnprefixedDid(credential.Subject)] = true
+		}
+	}
+	
+	start := types.DidKey
+	if params.After != "" {
+		start = append(types.GetDidPrefixKey(params.After), 0x00)
+	}
+	
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.DidKey))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		did := ixo.Did(iterator.Key()[len(types.DidKey):])
+		
+		var didDoc *types.BaseDidDoc
+		getDidDoc := func() types.BaseDidDoc {
+			if didDoc == nil {
+				didDoc = &types.BaseDidDoc{}
+				k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), didDoc)
+			}
+			return *didDoc
+		}
+		
+		if params.CreatedAfter > 0 && k.GetDidCreatedHeight(ctx, did) <= params.CreatedAfter {
+			continue
+		}
+		if credentialSubjects != nil && !credentialSubjects[ixo.UnprefixedDid(did)] &&
+			!getDidDoc().HasCredentialType(params.CredentialType) {
+			continue
+		}
+		
+		if cb(did, getDidDoc) {
+			return
+		}
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 	
 	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
@@ -34,6 +35,20 @@ func (k Keeper) GetDidDoc(ctx sdk.Context, did ixo.Did) (ixo.DidDoc, sdk.Error)
 	return didDoc, nil
 }
 
+// ResolveDidDoc returns the DID doc of a DID whether it was stored with or
+// without its method prefix
+func (k Keeper) ResolveDidDoc(ctx sdk.Context, did ixo.Did) (ixo.DidDoc, sdk.Error) {
+	unprefixedDid := ixo.UnprefixedDid(did)
+	for _, candidate := range []ixo.Did{did, unprefixedDid, ixo.DidPrefixIxo + unprefixedDid} {
+		didDoc, err := k.GetDidDoc(ctx, candidate)
+		if err == nil {
+			return didDoc, nil
+		}
+	}
+	
+	return nil, types.ErrorInvalidDid(types.DefaultCodeSpace, "Invalid Did Address")
+}
+
 func (k Keeper) SetDidDoc(ctx sdk.Context, did ixo.DidDoc) (err sdk.Error) {
 	existedDidDoc, err := k.GetDidDoc(ctx, did.GetDid())
 	if existedDidDoc != nil {
@@ -41,6 +56,8 @@ func (k Keeper) SetDidDoc(ctx sdk.Context, did ixo.DidDoc) (err sdk.Error) {
 	}
 	
 	k.AddDidDoc(ctx, did)
+	k.setDidCreatedHeight(ctx, did.GetDid(), ctx.BlockHeight())
+	k.setDidCount(ctx, k.GetDidCount(ctx)+1)
 	return nil
 }
 
@@ -71,6 +88,132 @@ func (k Keeper) AddCredentials(ctx sdk.Context, did ixo.Did, credential types.Di
 	return nil
 }
 
+// GetAuthenticationKeys returns the public keys, of any type, that can sign
+// txs on behalf of the DID
+func (k Keeper) GetAuthenticationKeys(ctx sdk.Context, did ixo.Did) ([]crypto.PubKey, sdk.Error) {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	
+	return didDoc.(types.BaseDidDoc).GetAuthenticationKeys(), nil
+}
+
+// GetSigningKeys returns the public keys that can sign the msg on behalf of
+// the DID: its own authentication keys, those of its controllers, and those
+// of the delegates whose delegation covers the msg type
+func (k Keeper) GetSigningKeys(ctx sdk.Context, did ixo.Did, msg sdk.Msg) ([]crypto.PubKey, sdk.Error) {
+	didDoc, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return nil, err
+	}
+	
+	baseDidDoc := didDoc.(types.BaseDidDoc)
+	pubKeys := baseDidDoc.GetAuthenticationKeys()
+	
+	for _, controllerDid := range baseDidDoc.Controllers {
+		controllerKeys, err := k.GetAuthenticationKeys(ctx, controllerDid)
+		if err == nil {
+			pubKeys = append(pubKeys, controllerKeys...)
+		}
+	}
+	
+	msgType := ixo.MsgTypeName(msg)
+	for _, delegation := range baseDidDoc.Delegations {
+		if !delegation.Covers(msgType, ctx.BlockHeader().Time) {
+			continue
+		}
+		
+		delegateKeys, err := k.GetAuthenticationKeys(ctx, delegation.DelegateDid)
+		if err == nil {
+			pubKeys = append(pubKeys, delegateKeys...)
+		}
+	}
+	
+	return pubKeys, nil
+}
+
+func (k Keeper) AddController(ctx sdk.Context, did ixo.Did, controllerDid ixo.Did) sdk.Error {
+	if _, err := k.GetDidDoc(ctx, controllerDid); err != nil {
+		return types.ErrorInvalidController(types.DefaultCodeSpace, "controller did not found")
+	}
+	
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		return didDoc.AddController(controllerDid)
+	})
+}
+
+func (k Keeper) RemoveController(ctx sdk.Context, did ixo.Did, controllerDid ixo.Did) sdk.Error {
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		return didDoc.RemoveController(controllerDid)
+	})
+}
+
+func (k Keeper) SetDelegation(ctx sdk.Context, did ixo.Did, delegation types.Delegation) sdk.Error {
+	if _, err := k.GetDidDoc(ctx, delegation.DelegateDid); err != nil {
+		return types.ErrorInvalidDelegation(types.DefaultCodeSpace, "delegate did not found")
+	} else if !delegation.Expiry.IsZero() && !delegation.Expiry.After(ctx.BlockHeader().Time) {
+		return types.ErrorInvalidDelegation(types.DefaultCodeSpace, "delegation has already expired")
+	}
+	
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		return didDoc.SetDelegation(delegation)
+	})
+}
+
+func (k Keeper) RemoveDelegation(ctx sdk.Context, did ixo.Did, delegateDid ixo.Did) sdk.Error {
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		return didDoc.RemoveDelegation(delegateDid)
+	})
+}
+
+func (k Keeper) SetKeyAgreementKey(ctx sdk.Context, did ixo.Did, keyAgreementKey string) sdk.Error {
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		didDoc.KeyAgreementKey = keyAgreementKey
+		return nil
+	})
+}
+
+func (k Keeper) AddService(ctx sdk.Context, did ixo.Did, service types.Service) sdk.Error {
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		return didDoc.AddService(service)
+	})
+}
+
+func (k Keeper) RemoveService(ctx sdk.Context, did ixo.Did, serviceId string) sdk.Error {
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		return didDoc.RemoveService(serviceId)
+	})
+}
+
+func (k Keeper) AddVerificationKey(ctx sdk.Context, did ixo.Did, key types.VerificationKey) sdk.Error {
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		return didDoc.AddVerificationKey(key)
+	})
+}
+
+func (k Keeper) RemoveVerificationKey(ctx sdk.Context, did ixo.Did, keyId string) sdk.Error {
+	return k.updateDidDoc(ctx, did, func(didDoc *types.BaseDidDoc) sdk.Error {
+		return didDoc.RemoveVerificationKey(keyId)
+	})
+}
+
+func (k Keeper) updateDidDoc(ctx sdk.Context, did ixo.Did, update func(didDoc *types.BaseDidDoc) sdk.Error) sdk.Error {
+	existedDid, err := k.GetDidDoc(ctx, did)
+	if err != nil {
+		return err
+	}
+	
+	baseDidDoc := existedDid.(types.BaseDidDoc)
+	if err := update(&baseDidDoc); err != nil {
+		return err
+	}
+	
+	k.AddDidDoc(ctx, baseDidDoc)
+	
+	return nil
+}
+
 func (k Keeper) GetAllDidDocs(ctx sdk.Context) (didDocs []ixo.DidDoc) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.DidKey)
@@ -92,3 +235,28 @@ func (k Keeper) GetAddDids(ctx sdk.Context) (dids []ixo.Did) {
 	
 	return dids
 }
+
+// GetDidMigrationReport lists the stored DID docs that were created before
+// DIDs had to be derived from their public key and fail that check
+func (k Keeper) GetDidMigrationReport(ctx sdk.Context) types.DidMigrationReport {
+	didDocs := k.GetAllDidDocs(ctx)
+	report := types.DidMigrationReport{
+		TotalDidDocs: uint64(len(didDocs)),
+		Underived:    []types.UnderivedDid{},
+	}
+	
+	for _, didDoc := range didDocs {
+		if ixo.IsDidDerivedFromPubKey(didDoc.GetDid(), didDoc.GetPubKey()) {
+			continue
+		}
+		
+		derivedDid, _ := ixo.DidFromPubKey(didDoc.GetPubKey())
+		report.Underived = append(report.Underived, types.UnderivedDid{
+			Did:        didDoc.GetDid(),
+			PubKey:     didDoc.GetPubKey(),
+			DerivedDid: derivedDid,
+		})
+	}
+	
+	return report
+}
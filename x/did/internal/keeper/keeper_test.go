@@ -2,13 +2,26 @@ package keeper
 
 import (
 	"testing"
+	"time"
 	
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	
 	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
 
+func ed25519PubKeys(pubKeys ...string) []crypto.PubKey {
+	keys := make([]crypto.PubKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		keys[i] = ixo.Ed25519PubKey(pubKey)
+	}
+	return keys
+}
+
 func TestKeeper(t *testing.T) {
 	ctx, k, cdc := CreateTestInput()
 	cdc.RegisterInterface((*ixo.DidDoc)(nil), nil)
@@ -21,3 +34,243 @@ func TestKeeper(t *testing.T) {
 	_, err = k.GetDidDoc(ctx, types.ValidDidDoc.GetDid())
 	require.Nil(t, err)
 }
+
+func TestKeeperDidMigrationReport(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	
+	// A DID squatted before derivation was checked, using someone else's key
+	squattedDidDoc := types.InitDidDoc("did:ixo:4XJLBfGtWSGKSz4BeRxdun", types.ValidDidDoc.PubKey)
+	prefixedDidDoc := types.InitDidDoc("did:sov:"+types.ValidDidDoc.Did, types.ValidDidDoc.PubKey)
+	k.AddDidDoc(ctx, types.ValidDidDoc)
+	k.AddDidDoc(ctx, squattedDidDoc)
+	k.AddDidDoc(ctx, prefixedDidDoc)
+	
+	report := k.GetDidMigrationReport(ctx)
+	require.Equal(t, uint64(3), report.TotalDidDocs)
+	require.Equal(t, []types.UnderivedDid{{
+		Did:        squattedDidDoc.Did,
+		PubKey:     squattedDidDoc.PubKey,
+		DerivedDid: types.ValidDidDoc.Did,
+	}}, report.Underived)
+}
+
+func TestKeeperServicesAndVerificationKeys(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	did := types.ValidDidDoc.Did
+	k.AddDidDoc(ctx, types.ValidDidDoc)
+	
+	authKey := types.VerificationKey{
+		Id:       "key-2",
+		PubKey:   "47mm6LCDAyJmqkbUbqGoZKZkBixjBgvDFRMwQRF9HWMU",
+		Purposes: []types.KeyPurpose{types.AuthenticationPurpose},
+	}
+	assertionKey := types.VerificationKey{
+		Id:       "key-3",
+		PubKey:   "2vMHhssdhrBCRFiq9vj7TxGYDybW4yYdrYh9JG56RaAt",
+		Purposes: []types.KeyPurpose{types.AssertionPurpose},
+	}
+	require.Nil(t, k.AddVerificationKey(ctx, did, authKey))
+	require.Nil(t, k.AddVerificationKey(ctx, did, assertionKey))
+	require.NotNil(t, k.AddVerificationKey(ctx, did, authKey))
+	
+	// Only the pubKey and keys with the authentication purpose can sign
+	authKeys, err := k.GetAuthenticationKeys(ctx, did)
+	require.Nil(t, err)
+	require.Equal(t, ed25519PubKeys(types.ValidDidDoc.PubKey, authKey.PubKey), authKeys)
+	
+	service := types.Service{Id: "cellnode", Type: "CellNode", ServiceEndpoint: "https://cellnode.ixo.world"}
+	require.Nil(t, k.AddService(ctx, did, service))
+	require.NotNil(t, k.AddService(ctx, did, service))
+	
+	didDoc, err := k.GetDidDoc(ctx, did)
+	require.Nil(t, err)
+	didDocument := types.NewDidDocument(didDoc.(types.BaseDidDoc))
+	require.Len(t, didDocument.VerificationMethod, 3)
+	require.Equal(t, []string{"did:ixo:" + did + "#key-1", "did:ixo:" + did + "#key-2"}, didDocument.Authentication)
+	require.Equal(t, []string{"did:ixo:" + did + "#key-1", "did:ixo:" + did + "#key-3"}, didDocument.AssertionMethod)
+	require.Equal(t, "did:ixo:"+did+"#cellnode", didDocument.Service[0].Id)
+	
+	require.Nil(t, k.RemoveVerificationKey(ctx, did, authKey.Id))
+	require.NotNil(t, k.RemoveVerificationKey(ctx, did, authKey.Id))
+	require.Nil(t, k.RemoveService(ctx, did, service.Id))
+	require.NotNil(t, k.RemoveService(ctx, did, service.Id))
+	
+	authKeys, err = k.GetAuthenticationKeys(ctx, did)
+	require.Nil(t, err)
+	require.Equal(t, ed25519PubKeys(types.ValidDidDoc.PubKey), authKeys)
+}
+
+func TestKeeperGetSigningKeys(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	
+	did := types.ValidDidDoc.Did
+	k.AddDidDoc(ctx, types.ValidDidDoc)
+	
+	newDidDoc := func(pubKey string) types.BaseDidDoc {
+		derivedDid, ok := ixo.DidFromPubKey(pubKey)
+		require.True(t, ok)
+		didDoc := types.InitDidDoc(derivedDid, pubKey)
+		k.AddDidDoc(ctx, didDoc)
+		return didDoc
+	}
+	controller := newDidDoc("47mm6LCDAyJmqkbUbqGoZKZkBixjBgvDFRMwQRF9HWMU")
+	delegate := newDidDoc("2vMHhssdhrBCRFiq9vj7TxGYDybW4yYdrYh9JG56RaAt")
+	
+	// Unknown controllers and past expiries are rejected
+	require.NotNil(t, k.AddController(ctx, did, "did:ixo:4XJLBfGtWSGKSz4BeRxdun"))
+	require.NotNil(t, k.SetDelegation(ctx, did, types.Delegation{
+		DelegateDid: delegate.Did,
+		MsgTypes:    []string{"did/*"},
+		Expiry:      now.Add(-time.Hour),
+	}))
+	
+	require.Nil(t, k.AddController(ctx, did, controller.Did))
+	require.Nil(t, k.SetDelegation(ctx, did, types.Delegation{
+		DelegateDid: delegate.Did,
+		MsgTypes:    []string{"did/AddServiceMsg"},
+		Expiry:      now.Add(time.Hour),
+	}))
+	
+	addService := types.NewAddServiceMsg(did, types.Service{})
+	removeService := types.NewRemoveServiceMsg(did, "service-1")
+	
+	keys, err := k.GetSigningKeys(ctx, did, addService)
+	require.Nil(t, err)
+	require.Equal(t, ed25519PubKeys(types.ValidDidDoc.PubKey, controller.PubKey, delegate.PubKey), keys)
+	
+	keys, err = k.GetSigningKeys(ctx, did, removeService)
+	require.Nil(t, err)
+	require.Equal(t, ed25519PubKeys(types.ValidDidDoc.PubKey, controller.PubKey), keys)
+	
+	// The delegation no longer applies once it has expired
+	keys, err = k.GetSigningKeys(ctx.WithBlockTime(now.Add(time.Hour)), did, addService)
+	require.Nil(t, err)
+	require.Equal(t, ed25519PubKeys(types.ValidDidDoc.PubKey, controller.PubKey), keys)
+	
+	// A wildcard covers every msg type of the route
+	require.Nil(t, k.SetDelegation(ctx, did, types.Delegation{
+		DelegateDid: delegate.Did,
+		MsgTypes:    []string{"did/*"},
+	}))
+	keys, err = k.GetSigningKeys(ctx, did, removeService)
+	require.Nil(t, err)
+	require.Equal(t, ed25519PubKeys(types.ValidDidDoc.PubKey, controller.PubKey, delegate.PubKey), keys)
+	
+	// A delegate cannot widen its own delegation or make itself a controller
+	for _, msg := range []sdk.Msg{
+		types.NewSetDelegationMsg(did, types.Delegation{DelegateDid: delegate.Did, MsgTypes: []string{"project/*"}}),
+		types.NewAddControllerMsg(did, delegate.Did),
+	} {
+		keys, err = k.GetSigningKeys(ctx, did, msg)
+		require.Nil(t, err)
+		require.Equal(t, ed25519PubKeys(types.ValidDidDoc.PubKey, controller.PubKey), keys)
+	}
+	for _, msgType := range []string{"did/SetDelegationMsg", "did/AddControllerMsg"} {
+		require.NotNil(t, types.NewSetDelegationMsg(did, types.Delegation{
+			DelegateDid: delegate.Did,
+			MsgTypes:    []string{msgType},
+		}).ValidateBasic())
+	}
+	
+	require.Nil(t, k.RemoveController(ctx, did, controller.Did))
+	require.Nil(t, k.RemoveDelegation(ctx, did, delegate.Did))
+	keys, err = k.GetSigningKeys(ctx, did, removeService)
+	require.Nil(t, err)
+	require.Equal(t, ed25519PubKeys(types.ValidDidDoc.PubKey), keys)
+}
+
+func TestKeeperSecp256k1VerificationKey(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	did := types.ValidDidDoc.Did
+	k.AddDidDoc(ctx, types.ValidDidDoc)
+	
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	key := types.VerificationKey{
+		Id:       "key-2",
+		PubKey:   base58.Encode(pubKey[:]),
+		Purposes: []types.KeyPurpose{types.AuthenticationPurpose},
+		Type:     types.Secp256k1VerificationKey2019,
+	}
+	require.Nil(t, k.AddVerificationKey(ctx, did, key))
+	
+	// The secp256k1 key signs msgs alongside the ed25519 pubKey
+	msg := types.NewRemoveServiceMsg(did, "service-1")
+	keys, err := k.GetSigningKeys(ctx, did, msg)
+	require.Nil(t, err)
+	require.Equal(t, []crypto.PubKey{ixo.Ed25519PubKey(types.ValidDidDoc.PubKey), pubKey}, keys)
+	
+	sig, sigErr := ixo.SignIxoMessageWithPrivKey(msg.GetSignBytes(), privKey)
+	require.Nil(t, sigErr)
+	require.True(t, ixo.VerifySignatureWithAnyPubKey(msg, keys, sig))
+	
+	otherSig, sigErr := ixo.SignIxoMessageWithPrivKey(msg.GetSignBytes(), secp256k1.GenPrivKey())
+	require.Nil(t, sigErr)
+	require.False(t, ixo.VerifySignatureWithAnyPubKey(msg, keys, otherSig))
+	
+	didDoc, err := k.GetDidDoc(ctx, did)
+	require.Nil(t, err)
+	didDocument := types.NewDidDocument(didDoc.(types.BaseDidDoc))
+	require.Equal(t, types.Secp256k1VerificationKey2019, didDocument.VerificationMethod[1].Type)
+	require.Contains(t, didDocument.Context, types.DidContextSecp256k1)
+}
+
+func TestKeeperGetDidDocs(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	
+	// DIDs A to E created at heights 1 to 5
+	dids := []ixo.Did{"did:ixo:A", "did:ixo:B", "did:ixo:C", "did:ixo:D", "did:ixo:E"}
+	for i, did := range dids {
+		require.Nil(t, k.SetDidDoc(ctx.WithBlockHeight(int64(i+1)), types.InitDidDoc(did, types.ValidDidDoc.PubKey)))
+	}
+	
+	// B holds an anchored credential and D a credential added to its doc
+	k.SetCredential(ctx, types.AnchoredCredential{
+		Hash:    "hash",
+		Subject: "did:ixo:B",
+		Issuer:  "did:ixo:A",
+		Types:   []string{"VerifiableCredential", "KYCCredential"},
+	})
+	require.Nil(t, k.AddCredentials(ctx, "did:ixo:D", types.DidCredential{
+		CredType: []string{"KYCCredential", "IxoCredential"},
+		Issuer:   "did:ixo:A",
+		Claim:    types.Claim{Id: "did:ixo:D", KYCValidated: true},
+	}))
+	
+	query := func(params types.QueryDidsParams) ([]ixo.Did, ixo.Did) {
+		didDocs, nextKey := k.GetDidDocs(ctx, params)
+		var dids []ixo.Did
+		for _, didDoc := range didDocs {
+			dids = append(dids, didDoc.Did)
+		}
+		return dids, nextKey
+	}
+	
+	page, nextKey := query(types.NewQueryDidsParams("", 0, "", 1, 2))
+	require.Equal(t, []ixo.Did{"did:ixo:A", "did:ixo:B"}, page)
+	require.Equal(t, ixo.Did("did:ixo:B"), nextKey)
+	
+	page, nextKey = query(types.NewQueryDidsParams("", 0, "", 3, 2))
+	require.Equal(t, []ixo.Did{"did:ixo:E"}, page)
+	require.Equal(t, ixo.Did(""), nextKey)
+	
+	// The next key of a page continues after its last DID
+	page, nextKey = query(types.NewQueryDidsParams("", 0, "did:ixo:B", 1, 2))
+	require.Equal(t, []ixo.Did{"did:ixo:C", "did:ixo:D"}, page)
+	require.Equal(t, ixo.Did("did:ixo:D"), nextKey)
+	
+	page, _ = query(types.NewQueryDidsParams("KYCCredential", 0, "", 1, 10))
+	require.Equal(t, []ixo.Did{"did:ixo:B", "did:ixo:D"}, page)
+	
+	page, _ = query(types.NewQueryDidsParams("", 3, "", 1, 10))
+	require.Equal(t, []ixo.Did{"did:ixo:D", "did:ixo:E"}, page)
+	
+	page, _ = query(types.NewQueryDidsParams("KYCCredential", 3, "", 1, 10))
+	require.Equal(t, []ixo.Did{"did:ixo:D"}, page)
+	
+	require.Equal(t, uint64(5), k.CountDidDocs(ctx, types.QueryDidsParams{}))
+	require.Equal(t, uint64(2), k.CountDidDocs(ctx, types.QueryDidsParams{CredentialType: "KYCCredential"}))
+	require.Equal(t, uint64(1), k.CountDidDocs(ctx, types.QueryDidsParams{CreatedAfter: 4}))
+}
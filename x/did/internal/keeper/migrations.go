@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"encoding/binary"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
+)
+
+// StoreVersion is the version of the did store layout that this keeper reads
+// and writes. MigrateStore upgrades older stores to it.
+const StoreVersion uint64 = 1
+
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StoreVersionKey)
+	if bz == nil {
+		return 0
+	}
+	
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StoreVersionKey, sdk.Uint64ToBigEndian(version))
+}
+
+// MigrateStore brings the did store up to StoreVersion. It is run at the
+// start of every block, and does nothing once the store is up to date.
+func (k Keeper) MigrateStore(ctx sdk.Context) {
+	version := k.GetStoreVersion(ctx)
+	if version >= StoreVersion {
+		return
+	}
+	
+	if version < 1 {
+		k.countDidDocsV1(ctx)
+	}
+	
+	k.SetStoreVersion(ctx, StoreVersion)
+}
+
+// countDidDocsV1 stores the number of DID docs created before they were
+// counted
+func (k Keeper) countDidDocsV1(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DidKey)
+	defer iterator.Close()
+	
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	
+	k.setDidCount(ctx, count)
+}
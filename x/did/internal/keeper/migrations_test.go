@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+func TestMigrateStoreCountsDidDocs(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	
+	// DID docs stored before they were counted
+	for _, did := range []ixo.Did{"did:ixo:A", "did:ixo:B", "did:ixo:C"} {
+		k.AddDidDoc(ctx, types.InitDidDoc(did, types.ValidDidDoc.PubKey))
+	}
+	require.Equal(t, uint64(0), k.GetStoreVersion(ctx))
+	require.Equal(t, uint64(0), k.CountDidDocs(ctx, types.QueryDidsParams{}))
+	
+	k.MigrateStore(ctx)
+	require.Equal(t, StoreVersion, k.GetStoreVersion(ctx))
+	require.Equal(t, uint64(3), k.CountDidDocs(ctx, types.QueryDidsParams{}))
+	
+	// New DID docs are counted as they are created
+	require.Nil(t, k.SetDidDoc(ctx, types.InitDidDoc("did:ixo:D", types.ValidDidDoc.PubKey)))
+	require.Equal(t, uint64(4), k.CountDidDocs(ctx, types.QueryDidsParams{}))
+	
+	// Migrating again does nothing
+	k.MigrateStore(ctx)
+	require.Equal(t, uint64(4), k.CountDidDocs(ctx, types.QueryDidsParams{}))
+}
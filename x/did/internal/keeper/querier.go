@@ -7,13 +7,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	
+	"github.com/ixofoundation/ixo-cosmos/x/did/internal/types"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
 
 const (
-	QueryDidDoc     = "queryDidDoc"
-	QueryAllDids    = "queryAllDids"
-	QueryAllDidDocs = "queryAllDidDocs"
+	QueryDidDoc      = "queryDidDoc"
+	QueryDidDocument = "queryDidDocument"
+	QueryDids        = "queryDids"
+	QueryDidDocs     = "queryDidDocs"
+	QueryDidCount    = "queryDidCount"
+	
+	QueryDidMigrationReport = "queryDidMigrationReport"
+	
+	QueryCredential           = "queryCredential"
+	QueryCredentialsBySubject = "queryCredentialsBySubject"
+	QueryCredentialsByIssuer  = "queryCredentialsByIssuer"
+	QueryCredentialsByType    = "queryCredentialsByType"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -21,10 +31,24 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryDidDoc:
 			return queryDidDoc(ctx, path[1:], k)
-		case QueryAllDids:
-			return queryAllDids(ctx, k)
-		case QueryAllDidDocs:
-			return queryAllDidDocs(ctx, k)
+		case QueryDidDocument:
+			return queryDidDocument(ctx, path[1:], k)
+		case QueryDids:
+			return queryDids(ctx, req, k)
+		case QueryDidDocs:
+			return queryDidDocs(ctx, req, k)
+		case QueryDidCount:
+			return queryDidCount(ctx, req, k)
+		case QueryDidMigrationReport:
+			return queryDidMigrationReport(ctx, k)
+		case QueryCredential:
+			return queryCredential(ctx, path[1:], k)
+		case QueryCredentialsBySubject:
+			return queryCredentials(k.GetCredentialsBySubject(ctx, path[1]))
+		case QueryCredentialsByIssuer:
+			return queryCredentials(k.GetCredentialsByIssuer(ctx, path[1]))
+		case QueryCredentialsByType:
+			return queryCredentials(k.GetCredentialsByType(ctx, path[1]))
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown did query endpoint")
 		}
@@ -45,10 +69,62 @@ func queryDidDoc(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
-func queryAllDids(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
-	allDids := k.GetAddDids(ctx)
+func queryDidDocument(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("DID document query requires a DID")
+	}
+	
+	didDoc, err := k.ResolveDidDoc(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
 	
-	res, errRes := json.Marshal(allDids)
+	res, errRes := json.Marshal(types.NewDidDocument(didDoc.(types.BaseDidDoc)))
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+	
+	return res, nil
+}
+
+// parseQueryDidsParams reads the params of a DID query, which only needs a
+// valid page and limit if it is paginated
+func parseQueryDidsParams(req abciTypes.RequestQuery, paginated bool) (types.QueryDidsParams, sdk.Error) {
+	var params types.QueryDidsParams
+	if err := json.Unmarshal(req.Data, &params); err != nil {
+		return params, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	
+	if err := params.ValidateFilters(); err != nil {
+		return params, sdk.ErrUnknownRequest(err.Error())
+	}
+	if paginated {
+		if err := params.ValidatePagination(); err != nil {
+			return params, types.ErrorInvalidPagination(types.DefaultCodeSpace, err.Error())
+		}
+	}
+	
+	return params, nil
+}
+
+func queryDids(ctx sdk.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	params, err := parseQueryDidsParams(req, true)
+	if err != nil {
+		return nil, err
+	}
+	
+	didDocs, nextKey := k.GetDidDocs(ctx, params)
+	result := types.QueryDidsResult{
+		Page:    params.Page,
+		Limit:   params.Limit,
+		NextKey: nextKey,
+		Dids:    []ixo.Did{},
+	}
+	for _, didDoc := range didDocs {
+		result.Dids = append(result.Dids, didDoc.Did)
+	}
+	
+	res, errRes := json.Marshal(result)
 	if errRes != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
 	}
@@ -56,11 +132,73 @@ func queryAllDids(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
-func queryAllDidDocs(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
-	var didDocs []ixo.DidDoc
-	didDocs = k.GetAllDidDocs(ctx)
+func queryDidDocs(ctx sdk.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	params, err := parseQueryDidsParams(req, true)
+	if err != nil {
+		return nil, err
+	}
 	
-	res, errRes := json.Marshal(didDocs)
+	didDocs, nextKey := k.GetDidDocs(ctx, params)
+	if didDocs == nil {
+		didDocs = []types.BaseDidDoc{}
+	}
+	
+	result := types.QueryDidDocsResult{
+		Page:    params.Page,
+		Limit:   params.Limit,
+		NextKey: nextKey,
+		DidDocs: didDocs,
+	}
+	
+	res, errRes := json.Marshal(result)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+	
+	return res, nil
+}
+
+func queryDidCount(ctx sdk.Context, req abciTypes.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	params, err := parseQueryDidsParams(req, false)
+	if err != nil {
+		return nil, err
+	}
+	
+	res, errRes := json.Marshal(types.QueryDidCountResult{Count: k.CountDidDocs(ctx, params)})
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+	
+	return res, nil
+}
+
+func queryDidMigrationReport(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	report := k.GetDidMigrationReport(ctx)
+	
+	res, errRes := json.Marshal(report)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+	
+	return res, nil
+}
+
+func queryCredential(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	credential, err := k.GetCredential(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+	
+	res, errRes := json.Marshal(credential)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+	
+	return res, nil
+}
+
+func queryCredentials(credentials []types.AnchoredCredential) ([]byte, sdk.Error) {
+	res, errRes := json.Marshal(credentials)
 	if errRes != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
 	}
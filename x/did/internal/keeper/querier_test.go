@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"testing"
 	
 	"github.com/stretchr/testify/require"
@@ -30,14 +31,95 @@ func TestQueryDidDocs(t *testing.T) {
 		t.Log(err)
 	}
 	_, _ = cdc.MarshalJSONIndent(a, "", " ")
-	resD, err := querier(ctx, []string{"queryAllDidDocs"}, query)
+	
+	params, _ := json.Marshal(types.NewQueryDidsParams("", 0, "", 1, types.DefaultDidsPerPage))
+	resD, err := querier(ctx, []string{QueryDidDocs}, abciTypes.RequestQuery{Data: params})
 	require.Nil(t, err)
 	
-	var b []types.BaseDidDoc
-	if err := cdc.UnmarshalJSON(resD, &b); err != nil {
-		t.Log(err)
-	}
+	var b types.QueryDidDocsResult
+	require.Nil(t, json.Unmarshal(resD, &b))
+	require.Len(t, b.DidDocs, 1)
+	require.Equal(t, types.ValidDidDoc.Did, b.DidDocs[0].Did)
+	
+	// Pages are bounded
+	params, _ = json.Marshal(types.NewQueryDidsParams("", 0, "", 1, types.MaxDidsPerPage+1))
+	_, err = querier(ctx, []string{QueryDidDocs}, abciTypes.RequestQuery{Data: params})
+	require.NotNil(t, err)
+	
+	// Pages past the last index are rejected rather than wrapping around
+	params, _ = json.Marshal(types.NewQueryDidsParams("", 0, "", 1<<63, 2))
+	_, err = querier(ctx, []string{QueryDidDocs}, abciTypes.RequestQuery{Data: params})
+	require.NotNil(t, err)
+	didDocs, _ := k.GetDidDocs(ctx, types.NewQueryDidsParams("", 0, "", 1<<63, 2))
+	require.Empty(t, didDocs)
+	
+	params, _ = json.Marshal(types.QueryDidsParams{})
+	res, err = querier(ctx, []string{QueryDidCount}, abciTypes.RequestQuery{Data: params})
+	require.Nil(t, err)
+	require.Equal(t, `{"count":1}`, string(res))
+}
+
+func TestQueryDidDocument(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	err := k.SetDidDoc(ctx, types.ValidDidDoc)
+	require.Nil(t, err)
+	
+	querier := NewQuerier(k)
+	query := abciTypes.RequestQuery{}
 	
-	_, _ = cdc.MarshalJSONIndent(b, "", " ")
+	// Unprefixed DIDs are resolved as did:ixo DIDs
+	did := "did:ixo:" + types.ValidDidDoc.Did
+	res, err := querier(ctx, []string{QueryDidDocument, did}, query)
+	require.Nil(t, err)
+	
+	var didDocument types.DidDocument
+	require.Nil(t, json.Unmarshal(res, &didDocument))
+	require.Equal(t, did, didDocument.Id)
+	require.Equal(t, []types.VerificationMethod{{
+		Id:              did + "#key-1",
+		Type:            types.Ed25519VerificationKey2018,
+		Controller:      did,
+		PublicKeyBase58: types.ValidDidDoc.PubKey,
+	}}, didDocument.VerificationMethod)
+	require.Equal(t, []string{did + "#key-1"}, didDocument.Authentication)
+	require.Equal(t, []string{did + "#key-1"}, didDocument.AssertionMethod)
+	
+	_, err = querier(ctx, []string{QueryDidDocument, "did:ixo:4XJLBfGtWSGKSz4BeRxdun"}, query)
+	require.NotNil(t, err)
+	
+	// Queries without a DID are rejected rather than panicking
+	_, err = querier(ctx, []string{QueryDidDocument}, query)
+	require.NotNil(t, err)
+}
+
+func TestQueryDidDocumentKeyAgreement(t *testing.T) {
+	ctx, k, _ := CreateTestInput()
+	require.Nil(t, k.SetDidDoc(ctx, types.ValidDidDoc))
 	
+	keyAgreementKey := "FWNwGaM51hqoutyrY1vAcYqpEnC4ucbz8JDBHjBefLDC"
+	require.Nil(t, k.SetKeyAgreementKey(ctx, types.ValidDidDoc.Did, keyAgreementKey))
+	
+	querier := NewQuerier(k)
+	res, err := querier(ctx, []string{QueryDidDocument, types.ValidDidDoc.Did}, abciTypes.RequestQuery{})
+	require.Nil(t, err)
+	
+	did := "did:ixo:" + types.ValidDidDoc.Did
+	var didDocument types.DidDocument
+	require.Nil(t, json.Unmarshal(res, &didDocument))
+	require.Contains(t, didDocument.Context, types.DidContextX25519)
+	require.Equal(t, []types.VerificationMethod{{
+		Id:              did + "#key-agreement-1",
+		Type:            types.X25519KeyAgreementKey2019,
+		Controller:      did,
+		PublicKeyBase58: keyAgreementKey,
+	}}, didDocument.KeyAgreement)
+	
+	// Removing the key removes it from the document
+	require.Nil(t, k.SetKeyAgreementKey(ctx, types.ValidDidDoc.Did, ""))
+	res, err = querier(ctx, []string{QueryDidDocument, types.ValidDidDoc.Did}, abciTypes.RequestQuery{})
+	require.Nil(t, err)
+	didDocument = types.DidDocument{}
+	require.Nil(t, json.Unmarshal(res, &didDocument))
+	require.Empty(t, didDocument.KeyAgreement)
+	require.NotContains(t, didDocument.Context, types.DidContextX25519)
 }
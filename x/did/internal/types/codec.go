@@ -9,6 +9,16 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(AddDidMsg{}, "did/AddDid", nil)
 	cdc.RegisterConcrete(AddCredentialMsg{}, "did/AddCredential", nil)
+	cdc.RegisterConcrete(AddServiceMsg{}, "did/AddService", nil)
+	cdc.RegisterConcrete(RemoveServiceMsg{}, "did/RemoveService", nil)
+	cdc.RegisterConcrete(AddVerificationKeyMsg{}, "did/AddVerificationKey", nil)
+	cdc.RegisterConcrete(RemoveVerificationKeyMsg{}, "did/RemoveVerificationKey", nil)
+	cdc.RegisterConcrete(AddVerifiableCredentialMsg{}, "did/AddVerifiableCredential", nil)
+	cdc.RegisterConcrete(AddControllerMsg{}, "did/AddController", nil)
+	cdc.RegisterConcrete(RemoveControllerMsg{}, "did/RemoveController", nil)
+	cdc.RegisterConcrete(SetDelegationMsg{}, "did/SetDelegation", nil)
+	cdc.RegisterConcrete(RemoveDelegationMsg{}, "did/RemoveDelegation", nil)
+	cdc.RegisterConcrete(SetKeyAgreementKeyMsg{}, "did/SetKeyAgreementKey", nil)
 	cdc.RegisterInterface((*ixo.DidDoc)(nil), nil)
 	
 }
@@ -0,0 +1,221 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/crypto/ed25519"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+const (
+	CredentialContextW3C     = "https://www.w3.org/2018/credentials/v1"
+	VerifiableCredentialType = "VerifiableCredential"
+	IxoEd25519Signature2020  = "IxoEd25519Signature2020"
+	AssertionProofPurpose    = "assertionMethod"
+)
+
+// jwsHeader is the protected header of the detached, unencoded payload JWS of
+// IxoEd25519Signature2020 proofs
+var jwsHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"EdDSA","b64":false,"crit":["b64"]}`))
+
+// CredentialProof is an IxoEd25519Signature2020 proof of a credential. Unlike
+// the Linked Data Ed25519Signature2018 suite, the credential is not
+// canonicalized with URDNA2015 but as compact JSON with sorted keys, as
+// written by encoding/json (which escapes <, > and &). The JWS signs the
+// sha256 of the proof options, which are the proof without its jws, followed
+// by the sha256 of the canonical credential.
+type CredentialProof struct {
+	Type               string `json:"type"`
+	Created            string `json:"created"`
+	VerificationMethod string `json:"verificationMethod"`
+	ProofPurpose       string `json:"proofPurpose"`
+	Jws                string `json:"jws"`
+}
+
+// AnchoredCredential is a W3C Verifiable Credential anchored on chain. Only
+// its hash and the indexed metadata are kept, unless the full credential is
+// stored too.
+type AnchoredCredential struct {
+	Hash           string          `json:"hash"`
+	Id             string          `json:"id,omitempty"`
+	Types          []string        `json:"types"`
+	Issuer         ixo.Did         `json:"issuer"`
+	Subject        ixo.Did         `json:"subject"`
+	IssuanceDate   string          `json:"issuanceDate"`
+	ExpirationDate string          `json:"expirationDate,omitempty"`
+	Proof          CredentialProof `json:"proof"`
+	Credential     string          `json:"credential,omitempty"`
+}
+
+// verifiableCredential holds the fields of a credential that are checked and
+// indexed. Any other fields are kept as they are in the credential JSON.
+type verifiableCredential struct {
+	Id                string          `json:"id"`
+	Type              []string        `json:"type"`
+	Issuer            json.RawMessage `json:"issuer"`
+	IssuanceDate      string          `json:"issuanceDate"`
+	ExpirationDate    string          `json:"expirationDate"`
+	CredentialSubject struct {
+		Id string `json:"id"`
+	} `json:"credentialSubject"`
+	Proof *CredentialProof `json:"proof"`
+}
+
+// ParseVerifiableCredential reads the metadata and proof of a credential, and
+// returns the canonical bytes of the credential without its proof, which are
+// what the proof signs. The hash of the credential is the sha256 of them.
+func ParseVerifiableCredential(credentialJson string) (AnchoredCredential, []byte, sdk.Error) {
+	var vc verifiableCredential
+	if err := json.Unmarshal([]byte(credentialJson), &vc); err != nil {
+		return AnchoredCredential{}, nil, ErrorInvalidCredentials(DefaultCodeSpace, fmt.Sprintf("invalid credential JSON: %s", err))
+	}
+	
+	issuer, err := parseCredentialIssuer(vc.Issuer)
+	if err != nil {
+		return AnchoredCredential{}, nil, ErrorInvalidCredentials(DefaultCodeSpace, err.Error())
+	} else if !containsString(vc.Type, VerifiableCredentialType) {
+		return AnchoredCredential{}, nil, ErrorInvalidCredentials(DefaultCodeSpace, "type must include VerifiableCredential")
+	} else if vc.IssuanceDate == "" {
+		return AnchoredCredential{}, nil, ErrorInvalidCredentials(DefaultCodeSpace, "issuanceDate should not be empty")
+	} else if vc.CredentialSubject.Id == "" {
+		return AnchoredCredential{}, nil, ErrorInvalidCredentials(DefaultCodeSpace, "credentialSubject id should not be empty")
+	} else if vc.Proof == nil {
+		return AnchoredCredential{}, nil, ErrorInvalidCredentials(DefaultCodeSpace, "proof should not be empty")
+	}
+	
+	canonical, err := CanonicalCredentialBytes(credentialJson)
+	if err != nil {
+		return AnchoredCredential{}, nil, ErrorInvalidCredentials(DefaultCodeSpace, err.Error())
+	}
+	hash := sha256.Sum256(canonical)
+	
+	return AnchoredCredential{
+		Hash:           hex.EncodeToString(hash[:]),
+		Id:             vc.Id,
+		Types:          vc.Type,
+		Issuer:         issuer,
+		Subject:        vc.CredentialSubject.Id,
+		IssuanceDate:   vc.IssuanceDate,
+		ExpirationDate: vc.ExpirationDate,
+		Proof:          *vc.Proof,
+	}, canonical, nil
+}
+
+// CanonicalCredentialBytes returns the credential without its proof, as
+// compact JSON with sorted keys
+func CanonicalCredentialBytes(credentialJson string) ([]byte, error) {
+	var credential map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(credentialJson))
+	decoder.UseNumber()
+	if err := decoder.Decode(&credential); err != nil {
+		return nil, err
+	}
+	delete(credential, "proof")
+	
+	return json.Marshal(credential)
+}
+
+// The issuer of a credential is either its DID or an object with the DID as id
+func parseCredentialIssuer(raw json.RawMessage) (ixo.Did, error) {
+	var issuer string
+	if err := json.Unmarshal(raw, &issuer); err != nil {
+		var issuerObj struct {
+			Id string `json:"id"`
+		}
+		if err := json.Unmarshal(raw, &issuerObj); err != nil {
+			return "", fmt.Errorf("issuer must be a DID or an object with an id")
+		}
+		issuer = issuerObj.Id
+	}
+	
+	if issuer == "" {
+		return "", fmt.Errorf("issuer should not be empty")
+	}
+	return issuer, nil
+}
+
+// SplitVerificationMethod splits a verification method such as
+// did:ixo:abc#key-1 into the DID and the key id
+func SplitVerificationMethod(verificationMethod string) (ixo.Did, string, bool) {
+	parts := strings.SplitN(verificationMethod, "#", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// GetAssertionKey returns the public key with the id if it can be used to
+// assert credentials
+func (dd BaseDidDoc) GetAssertionKey(keyId string) (string, bool) {
+	if keyId == PrimaryKeyId {
+		return dd.PubKey, true
+	}
+	
+	for _, key := range dd.VerificationKeys {
+		if key.Id == keyId && key.HasPurpose(AssertionPurpose) {
+			return key.PubKey, true
+		}
+	}
+	
+	return "", false
+}
+
+// SignCredentialJws returns the detached JWS of the proof over its options and
+// the canonical credential bytes
+func SignCredentialJws(proof CredentialProof, canonical []byte, signKey string, verifyKey string) string {
+	privKey := make([]byte, ed25519.PrivateKeySize)
+	copy(privKey[:32], base58.Decode(signKey))
+	copy(privKey[32:], base58.Decode(verifyKey))
+	
+	signature := ed25519.Sign(privKey, jwsSigningInput(proof, canonical))
+	
+	return jwsHeader + ".." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// VerifyCredentialJws checks the detached JWS of the proof against its
+// options and the canonical credential bytes
+func VerifyCredentialJws(proof CredentialProof, canonical []byte, pubKey string) bool {
+	parts := strings.Split(proof.Jws, ".")
+	if len(parts) != 3 || parts[0] != jwsHeader || parts[1] != "" {
+		return false
+	}
+	
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	
+	pubKeyBytes := base58.Decode(pubKey)
+	if len(pubKeyBytes) != ed25519.PublicKeySize {
+		return false
+	}
+	
+	return ed25519.Verify(pubKeyBytes, jwsSigningInput(proof, canonical), signature)
+}
+
+func jwsSigningInput(proof CredentialProof, canonical []byte) []byte {
+	proof.Jws = ""
+	options, _ := json.Marshal(proof)
+	optionsHash := sha256.Sum256(options)
+	credentialHash := sha256.Sum256(canonical)
+	
+	input := append([]byte(jwsHeader+"."), optionsHash[:]...)
+	return append(input, credentialHash[:]...)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+const (
+	DidContextW3C            = "https://www.w3.org/ns/did/v1"
+	DidContextEd25519        = "https://w3id.org/security/suites/ed25519-2018/v1"
+	DidContextX25519         = "https://w3id.org/security/suites/x25519-2019/v1"
+	DidContextSecp256k1      = "https://w3id.org/security/suites/secp256k1-2019/v1"
+	DidResolutionContext     = "https://w3id.org/did-resolution/v1"
+	DidDocumentContentType   = "application/did+ld+json"
+	DidResolutionContentType = `application/ld+json;profile="https://w3id.org/did-resolution"`
+	
+	Ed25519VerificationKey2018   = "Ed25519VerificationKey2018"
+	Secp256k1VerificationKey2019 = "EcdsaSecp256k1VerificationKey2019"
+	X25519KeyAgreementKey2019    = "X25519KeyAgreementKey2019"
+)
+
+// DidDocument is a W3C DID Core document, as served to SSI wallets and
+// verifiers. It is derived from the stored BaseDidDoc.
+type DidDocument struct {
+	Context            []string             `json:"@context"`
+	Id                 ixo.Did              `json:"id"`
+	Controller         []ixo.Did            `json:"controller,omitempty"`
+	VerificationMethod []VerificationMethod `json:"verificationMethod"`
+	Authentication     []string             `json:"authentication"`
+	AssertionMethod    []string             `json:"assertionMethod"`
+	KeyAgreement       []VerificationMethod `json:"keyAgreement,omitempty"`
+	Service            []Service            `json:"service"`
+}
+
+type VerificationMethod struct {
+	Id              string  `json:"id"`
+	Type            string  `json:"type"`
+	Controller      ixo.Did `json:"controller"`
+	PublicKeyBase58 string  `json:"publicKeyBase58"`
+}
+
+type Service struct {
+	Id              string `json:"id"`
+	Type            string `json:"type"`
+	ServiceEndpoint string `json:"serviceEndpoint"`
+}
+
+// NewDidDocument converts a stored DID doc to a W3C DID document. The public
+// key of the doc becomes its #key-1 verification method, which is used both
+// for authentication and for assertions. Additional keys are listed with
+// their own type under the purposes they were added with, and the key
+// agreement key, if any, is embedded under keyAgreement.
+func NewDidDocument(didDoc BaseDidDoc) DidDocument {
+	did := ixo.PrefixedDid(didDoc.Did)
+	keyId := did + "#" + PrimaryKeyId
+	
+	didDocument := DidDocument{
+		Context: []string{DidContextW3C, DidContextEd25519},
+		Id:      did,
+		VerificationMethod: []VerificationMethod{{
+			Id:              keyId,
+			Type:            Ed25519VerificationKey2018,
+			Controller:      did,
+			PublicKeyBase58: didDoc.PubKey,
+		}},
+		Authentication:  []string{keyId},
+		AssertionMethod: []string{keyId},
+		Service:         []Service{},
+	}
+	
+	hasSecp256k1Key := false
+	for _, key := range didDoc.VerificationKeys {
+		keyId := did + "#" + key.Id
+		if key.KeyType() == Secp256k1VerificationKey2019 {
+			hasSecp256k1Key = true
+		}
+		didDocument.VerificationMethod = append(didDocument.VerificationMethod, VerificationMethod{
+			Id:              keyId,
+			Type:            key.KeyType(),
+			Controller:      did,
+			PublicKeyBase58: key.PubKey,
+		})
+		if key.HasPurpose(AuthenticationPurpose) {
+			didDocument.Authentication = append(didDocument.Authentication, keyId)
+		}
+		if key.HasPurpose(AssertionPurpose) {
+			didDocument.AssertionMethod = append(didDocument.AssertionMethod, keyId)
+		}
+	}
+	
+	if hasSecp256k1Key {
+		didDocument.Context = append(didDocument.Context, DidContextSecp256k1)
+	}
+	
+	if didDoc.KeyAgreementKey != "" {
+		didDocument.Context = append(didDocument.Context, DidContextX25519)
+		didDocument.KeyAgreement = []VerificationMethod{{
+			Id:              did + "#" + KeyAgreementKeyId,
+			Type:            X25519KeyAgreementKey2019,
+			Controller:      did,
+			PublicKeyBase58: didDoc.KeyAgreementKey,
+		}}
+	}
+	
+	for _, controller := range didDoc.Controllers {
+		didDocument.Controller = append(didDocument.Controller, ixo.PrefixedDid(controller))
+	}
+	
+	for _, service := range didDoc.Services {
+		didDocument.Service = append(didDocument.Service, Service{
+			Id:              did + "#" + service.Id,
+			Type:            service.Type,
+			ServiceEndpoint: service.ServiceEndpoint,
+		})
+	}
+	
+	return didDocument
+}
+
+// DidResolutionResult is the response of a Universal Resolver driver
+type DidResolutionResult struct {
+	Context               string                `json:"@context"`
+	DidDocument           *DidDocument          `json:"didDocument"`
+	DidResolutionMetadata DidResolutionMetadata `json:"didResolutionMetadata"`
+	DidDocumentMetadata   struct{}              `json:"didDocumentMetadata"`
+}
+
+type DidResolutionMetadata struct {
+	ContentType string `json:"contentType,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+func NewDidResolutionResult(didDocument DidDocument) DidResolutionResult {
+	return DidResolutionResult{
+		Context:     DidResolutionContext,
+		DidDocument: &didDocument,
+		DidResolutionMetadata: DidResolutionMetadata{
+			ContentType: DidDocumentContentType,
+		},
+	}
+}
@@ -5,11 +5,18 @@ import (
 )
 
 const (
-	DefaultCodeSpace       sdk.CodespaceType = ModuleName
-	CodeInvalidDid                           = 201
-	CodeInvalidPubKey                        = 202
-	CodeInvalidIssuer                        = 203
-	CodeInvalidCredentials                   = 204
+	DefaultCodeSpace            sdk.CodespaceType = ModuleName
+	CodeInvalidDid                                = 201
+	CodeInvalidPubKey                             = 202
+	CodeInvalidIssuer                             = 203
+	CodeInvalidCredentials                        = 204
+	CodeDidNotDerivedFromPubKey                   = 205
+	CodeInvalidService                            = 206
+	CodeInvalidVerificationKey                    = 207
+	CodeInvalidController                         = 208
+	CodeInvalidDelegation                         = 209
+	CodeInvalidPagination                         = 210
+	CodeInvalidKeyAgreementKey                    = 211
 )
 
 func ErrorInvalidDid(codeSpace sdk.CodespaceType, msg string) sdk.Error {
@@ -43,3 +50,59 @@ func ErrorInvalidCredentials(codeSpace sdk.CodespaceType, msg string) sdk.Error
 	
 	return sdk.NewError(codeSpace, CodeInvalidCredentials, "Data already exist")
 }
+
+func ErrorDidNotDerivedFromPubKey(codeSpace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codeSpace, CodeDidNotDerivedFromPubKey, msg)
+	}
+	
+	return sdk.NewError(codeSpace, CodeDidNotDerivedFromPubKey, "Did is not derived from pubKey")
+}
+
+func ErrorInvalidService(codeSpace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codeSpace, CodeInvalidService, msg)
+	}
+	
+	return sdk.NewError(codeSpace, CodeInvalidService, "Invalid service")
+}
+
+func ErrorInvalidVerificationKey(codeSpace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codeSpace, CodeInvalidVerificationKey, msg)
+	}
+	
+	return sdk.NewError(codeSpace, CodeInvalidVerificationKey, "Invalid verification key")
+}
+
+func ErrorInvalidController(codeSpace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codeSpace, CodeInvalidController, msg)
+	}
+	
+	return sdk.NewError(codeSpace, CodeInvalidController, "Invalid controller")
+}
+
+func ErrorInvalidDelegation(codeSpace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codeSpace, CodeInvalidDelegation, msg)
+	}
+	
+	return sdk.NewError(codeSpace, CodeInvalidDelegation, "Invalid delegation")
+}
+
+func ErrorInvalidPagination(codeSpace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codeSpace, CodeInvalidPagination, msg)
+	}
+	
+	return sdk.NewError(codeSpace, CodeInvalidPagination, "Invalid pagination")
+}
+
+func ErrorInvalidKeyAgreementKey(codeSpace sdk.CodespaceType, msg string) sdk.Error {
+	if msg != "" {
+		return sdk.NewError(codeSpace, CodeInvalidKeyAgreementKey, msg)
+	}
+	
+	return sdk.NewError(codeSpace, CodeInvalidKeyAgreementKey, "Invalid key agreement key")
+}
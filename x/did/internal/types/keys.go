@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
 
@@ -11,8 +13,58 @@ const (
 	QuerierRoute = RouterKey
 )
 
-var DidKey = []byte{0x01}
+var (
+	DidKey = []byte{0x01}
+
+	CredentialKey             = []byte{0x02}
+	CredentialSubjectIndexKey = []byte{0x03}
+	CredentialIssuerIndexKey  = []byte{0x04}
+	CredentialTypeIndexKey    = []byte{0x05}
+
+	DidCreatedHeightKey = []byte{0x06}
+	DidCountKey         = []byte{0x07}
+	StoreVersionKey     = []byte{0x08}
+)
 
 func GetDidPrefixKey(did ixo.Did) []byte {
 	return append(DidKey, []byte(did)...)
 }
+
+func GetDidCreatedHeightKey(did ixo.Did) []byte {
+	return append(DidCreatedHeightKey, []byte(did)...)
+}
+
+func GetCredentialKey(hash string) []byte {
+	return append(CredentialKey, []byte(hash)...)
+}
+
+// getCredentialIndexPrefixKey prefixes the indexed value with its length, so
+// that no value is a prefix of another's index keys, whatever its characters
+func getCredentialIndexPrefixKey(indexKey []byte, value string) []byte {
+	key := append(append([]byte{}, indexKey...), sdk.Uint64ToBigEndian(uint64(len(value)))...)
+	return append(key, []byte(value)...)
+}
+
+func GetCredentialSubjectPrefixKey(subject ixo.Did) []byte {
+	return getCredentialIndexPrefixKey(CredentialSubjectIndexKey, subject)
+}
+
+func GetCredentialSubjectIndexKey(subject ixo.Did, hash string) []byte {
+	return append(GetCredentialSubjectPrefixKey(subject), []byte(hash)...)
+}
+
+func GetCredentialIssuerPrefixKey(issuer ixo.Did) []byte {
+	return getCredentialIndexPrefixKey(CredentialIssuerIndexKey, issuer)
+}
+
+func GetCredentialIssuerIndexKey(issuer ixo.Did, hash string) []byte {
+	return append(GetCredentialIssuerPrefixKey(issuer), []byte(hash)...)
+}
+
+func GetCredentialTypePrefixKey(credType string) []byte {
+	return getCredentialIndexPrefixKey(CredentialTypeIndexKey, credType)
+}
+
+func GetCredentialTypeIndexKey(credType string, hash string) []byte {
+	return append(GetCredentialTypePrefixKey(credType), []byte(hash)...)
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
 
 type AddDidMsg struct {
@@ -39,6 +41,14 @@ func (msg AddDidMsg) ValidateBasic() sdk.Error {
 		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
 	} else if msg.DidDoc.PubKey == "" {
 		return ErrorInvalidPubKey(DefaultCodeSpace, "pubKey should not be empty")
+	} else if !ixo.IsDidDerivedFromPubKey(msg.DidDoc.Did, msg.DidDoc.PubKey) {
+		return ErrorDidNotDerivedFromPubKey(DefaultCodeSpace, "did must be derived from pubKey")
+	}
+	
+	if msg.DidDoc.KeyAgreementKey != "" {
+		if err := ValidateKeyAgreementKey(msg.DidDoc.KeyAgreementKey); err != nil {
+			return err
+		}
 	}
 	
 	for _, credential := range msg.DidDoc.Credentials {
@@ -115,3 +125,464 @@ func (msg AddCredentialMsg) GetSignBytes() []byte {
 }
 
 func (msg AddCredentialMsg) IsNewDid() bool { return false }
+
+type AddServiceMsg struct {
+	Did     ixo.Did `json:"did"`
+	Service Service `json:"service"`
+}
+
+func NewAddServiceMsg(did ixo.Did, service Service) AddServiceMsg {
+	return AddServiceMsg{
+		Did:     did,
+		Service: service,
+	}
+}
+
+var _ sdk.Msg = AddServiceMsg{}
+
+func (msg AddServiceMsg) Type() string  { return "did" }
+func (msg AddServiceMsg) Route() string { return RouterKey }
+func (msg AddServiceMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg AddServiceMsg) String() string {
+	return fmt.Sprintf("AddServiceMsg{Did: %v, Service: %v}", msg.Did, msg.Service.Id)
+}
+
+func (msg AddServiceMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	}
+	
+	return msg.Service.Validate()
+}
+
+func (msg AddServiceMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg AddServiceMsg) IsNewDid() bool { return false }
+
+type RemoveServiceMsg struct {
+	Did       ixo.Did `json:"did"`
+	ServiceId string  `json:"serviceId"`
+}
+
+func NewRemoveServiceMsg(did ixo.Did, serviceId string) RemoveServiceMsg {
+	return RemoveServiceMsg{
+		Did:       did,
+		ServiceId: serviceId,
+	}
+}
+
+var _ sdk.Msg = RemoveServiceMsg{}
+
+func (msg RemoveServiceMsg) Type() string  { return "did" }
+func (msg RemoveServiceMsg) Route() string { return RouterKey }
+func (msg RemoveServiceMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg RemoveServiceMsg) String() string {
+	return fmt.Sprintf("RemoveServiceMsg{Did: %v, ServiceId: %v}", msg.Did, msg.ServiceId)
+}
+
+func (msg RemoveServiceMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	} else if msg.ServiceId == "" {
+		return ErrorInvalidService(DefaultCodeSpace, "service id should not be empty")
+	}
+	
+	return nil
+}
+
+func (msg RemoveServiceMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg RemoveServiceMsg) IsNewDid() bool { return false }
+
+type AddVerificationKeyMsg struct {
+	Did             ixo.Did         `json:"did"`
+	VerificationKey VerificationKey `json:"verificationKey"`
+}
+
+func NewAddVerificationKeyMsg(did ixo.Did, key VerificationKey) AddVerificationKeyMsg {
+	return AddVerificationKeyMsg{
+		Did:             did,
+		VerificationKey: key,
+	}
+}
+
+var _ sdk.Msg = AddVerificationKeyMsg{}
+
+func (msg AddVerificationKeyMsg) Type() string  { return "did" }
+func (msg AddVerificationKeyMsg) Route() string { return RouterKey }
+func (msg AddVerificationKeyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg AddVerificationKeyMsg) String() string {
+	return fmt.Sprintf("AddVerificationKeyMsg{Did: %v, KeyId: %v, Purposes: %v}",
+		msg.Did, msg.VerificationKey.Id, msg.VerificationKey.Purposes)
+}
+
+func (msg AddVerificationKeyMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	}
+	
+	return msg.VerificationKey.Validate()
+}
+
+func (msg AddVerificationKeyMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg AddVerificationKeyMsg) IsNewDid() bool { return false }
+
+type RemoveVerificationKeyMsg struct {
+	Did   ixo.Did `json:"did"`
+	KeyId string  `json:"keyId"`
+}
+
+func NewRemoveVerificationKeyMsg(did ixo.Did, keyId string) RemoveVerificationKeyMsg {
+	return RemoveVerificationKeyMsg{
+		Did:   did,
+		KeyId: keyId,
+	}
+}
+
+var _ sdk.Msg = RemoveVerificationKeyMsg{}
+
+func (msg RemoveVerificationKeyMsg) Type() string  { return "did" }
+func (msg RemoveVerificationKeyMsg) Route() string { return RouterKey }
+func (msg RemoveVerificationKeyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg RemoveVerificationKeyMsg) String() string {
+	return fmt.Sprintf("RemoveVerificationKeyMsg{Did: %v, KeyId: %v}", msg.Did, msg.KeyId)
+}
+
+func (msg RemoveVerificationKeyMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	} else if msg.KeyId == "" {
+		return ErrorInvalidVerificationKey(DefaultCodeSpace, "key id should not be empty")
+	}
+	
+	return nil
+}
+
+func (msg RemoveVerificationKeyMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg RemoveVerificationKeyMsg) IsNewDid() bool { return false }
+
+// AddVerifiableCredentialMsg anchors a W3C Verifiable Credential, signed with
+// an IxoEd25519Signature2020 proof by one of the issuer's assertion keys. The
+// full credential is kept on chain only if StoreCredential is set.
+type AddVerifiableCredentialMsg struct {
+	IssuerDid       ixo.Did `json:"issuerDid"`
+	Credential      string  `json:"credential"`
+	StoreCredential bool    `json:"storeCredential"`
+}
+
+func NewAddVerifiableCredentialMsg(issuerDid ixo.Did, credential string, storeCredential bool) AddVerifiableCredentialMsg {
+	return AddVerifiableCredentialMsg{
+		IssuerDid:       issuerDid,
+		Credential:      credential,
+		StoreCredential: storeCredential,
+	}
+}
+
+var _ sdk.Msg = AddVerifiableCredentialMsg{}
+
+func (msg AddVerifiableCredentialMsg) Type() string  { return "did" }
+func (msg AddVerifiableCredentialMsg) Route() string { return RouterKey }
+func (msg AddVerifiableCredentialMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.IssuerDid)}
+}
+
+func (msg AddVerifiableCredentialMsg) String() string {
+	return fmt.Sprintf("AddVerifiableCredentialMsg{IssuerDid: %v, StoreCredential: %v}",
+		msg.IssuerDid, msg.StoreCredential)
+}
+
+func (msg AddVerifiableCredentialMsg) ValidateBasic() sdk.Error {
+	if msg.IssuerDid == "" {
+		return ErrorInvalidIssuer(DefaultCodeSpace, "issuer did should not be empty")
+	}
+	
+	credential, _, err := ParseVerifiableCredential(msg.Credential)
+	if err != nil {
+		return err
+	} else if ixo.UnprefixedDid(credential.Issuer) != ixo.UnprefixedDid(msg.IssuerDid) {
+		return ErrorInvalidIssuer(DefaultCodeSpace, "issuer did must be the credential issuer")
+	}
+	
+	return nil
+}
+
+func (msg AddVerifiableCredentialMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg AddVerifiableCredentialMsg) IsNewDid() bool { return false }
+
+type AddControllerMsg struct {
+	Did           ixo.Did `json:"did"`
+	ControllerDid ixo.Did `json:"controllerDid"`
+}
+
+func NewAddControllerMsg(did ixo.Did, controllerDid ixo.Did) AddControllerMsg {
+	return AddControllerMsg{
+		Did:           did,
+		ControllerDid: controllerDid,
+	}
+}
+
+var _ sdk.Msg = AddControllerMsg{}
+
+func (msg AddControllerMsg) Type() string  { return "did" }
+func (msg AddControllerMsg) Route() string { return RouterKey }
+func (msg AddControllerMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg AddControllerMsg) String() string {
+	return fmt.Sprintf("AddControllerMsg{Did: %v, ControllerDid: %v}", msg.Did, msg.ControllerDid)
+}
+
+func (msg AddControllerMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	} else if msg.ControllerDid == "" {
+		return ErrorInvalidController(DefaultCodeSpace, "controller did should not be empty")
+	}
+	
+	return nil
+}
+
+func (msg AddControllerMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg AddControllerMsg) IsNewDid() bool { return false }
+
+type RemoveControllerMsg struct {
+	Did           ixo.Did `json:"did"`
+	ControllerDid ixo.Did `json:"controllerDid"`
+}
+
+func NewRemoveControllerMsg(did ixo.Did, controllerDid ixo.Did) RemoveControllerMsg {
+	return RemoveControllerMsg{
+		Did:           did,
+		ControllerDid: controllerDid,
+	}
+}
+
+var _ sdk.Msg = RemoveControllerMsg{}
+
+func (msg RemoveControllerMsg) Type() string  { return "did" }
+func (msg RemoveControllerMsg) Route() string { return RouterKey }
+func (msg RemoveControllerMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg RemoveControllerMsg) String() string {
+	return fmt.Sprintf("RemoveControllerMsg{Did: %v, ControllerDid: %v}", msg.Did, msg.ControllerDid)
+}
+
+func (msg RemoveControllerMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	} else if msg.ControllerDid == "" {
+		return ErrorInvalidController(DefaultCodeSpace, "controller did should not be empty")
+	}
+	
+	return nil
+}
+
+func (msg RemoveControllerMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg RemoveControllerMsg) IsNewDid() bool { return false }
+
+// SetDelegationMsg adds a delegation to the DID doc, or replaces the existing
+// delegation to the same delegate
+type SetDelegationMsg struct {
+	Did        ixo.Did    `json:"did"`
+	Delegation Delegation `json:"delegation"`
+}
+
+func NewSetDelegationMsg(did ixo.Did, delegation Delegation) SetDelegationMsg {
+	return SetDelegationMsg{
+		Did:        did,
+		Delegation: delegation,
+	}
+}
+
+var _ sdk.Msg = SetDelegationMsg{}
+
+func (msg SetDelegationMsg) Type() string  { return "did" }
+func (msg SetDelegationMsg) Route() string { return RouterKey }
+func (msg SetDelegationMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg SetDelegationMsg) String() string {
+	return fmt.Sprintf("SetDelegationMsg{Did: %v, DelegateDid: %v, MsgTypes: %v}",
+		msg.Did, msg.Delegation.DelegateDid, msg.Delegation.MsgTypes)
+}
+
+func (msg SetDelegationMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	}
+	
+	return msg.Delegation.Validate()
+}
+
+func (msg SetDelegationMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg SetDelegationMsg) IsNewDid() bool { return false }
+
+type RemoveDelegationMsg struct {
+	Did         ixo.Did `json:"did"`
+	DelegateDid ixo.Did `json:"delegateDid"`
+}
+
+func NewRemoveDelegationMsg(did ixo.Did, delegateDid ixo.Did) RemoveDelegationMsg {
+	return RemoveDelegationMsg{
+		Did:         did,
+		DelegateDid: delegateDid,
+	}
+}
+
+var _ sdk.Msg = RemoveDelegationMsg{}
+
+func (msg RemoveDelegationMsg) Type() string  { return "did" }
+func (msg RemoveDelegationMsg) Route() string { return RouterKey }
+func (msg RemoveDelegationMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg RemoveDelegationMsg) String() string {
+	return fmt.Sprintf("RemoveDelegationMsg{Did: %v, DelegateDid: %v}", msg.Did, msg.DelegateDid)
+}
+
+func (msg RemoveDelegationMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	} else if msg.DelegateDid == "" {
+		return ErrorInvalidDelegation(DefaultCodeSpace, "delegate did should not be empty")
+	}
+	
+	return nil
+}
+
+func (msg RemoveDelegationMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg RemoveDelegationMsg) IsNewDid() bool { return false }
+
+// SetKeyAgreementKeyMsg publishes the curve25519 key that others can encrypt
+// data for the DID with. An empty key removes it.
+type SetKeyAgreementKeyMsg struct {
+	Did             ixo.Did `json:"did"`
+	KeyAgreementKey string  `json:"keyAgreementKey"`
+}
+
+func NewSetKeyAgreementKeyMsg(did ixo.Did, keyAgreementKey string) SetKeyAgreementKeyMsg {
+	return SetKeyAgreementKeyMsg{
+		Did:             did,
+		KeyAgreementKey: keyAgreementKey,
+	}
+}
+
+var _ sdk.Msg = SetKeyAgreementKeyMsg{}
+
+func (msg SetKeyAgreementKeyMsg) Type() string  { return "did" }
+func (msg SetKeyAgreementKeyMsg) Route() string { return RouterKey }
+func (msg SetKeyAgreementKeyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.Did)}
+}
+
+func (msg SetKeyAgreementKeyMsg) String() string {
+	return fmt.Sprintf("SetKeyAgreementKeyMsg{Did: %v, KeyAgreementKey: %v}", msg.Did, msg.KeyAgreementKey)
+}
+
+func (msg SetKeyAgreementKeyMsg) ValidateBasic() sdk.Error {
+	if msg.Did == "" {
+		return ErrorInvalidDid(DefaultCodeSpace, "did should not be empty")
+	} else if msg.KeyAgreementKey != "" {
+		return ValidateKeyAgreementKey(msg.KeyAgreementKey)
+	}
+	
+	return nil
+}
+
+func (msg SetKeyAgreementKeyMsg) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	
+	return b
+}
+
+func (msg SetKeyAgreementKeyMsg) IsNewDid() bool { return false }
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"testing"
+	
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestAddDidMsgValidateBasic(t *testing.T) {
+	msg := NewAddDidMsg(ValidDidDoc.Did, ValidDidDoc.PubKey)
+	require.Nil(t, msg.ValidateBasic())
+	
+	// The did:ixo: and did:sov: method prefixes are accepted
+	msg = NewAddDidMsg("did:ixo:"+ValidDidDoc.Did, ValidDidDoc.PubKey)
+	require.Nil(t, msg.ValidateBasic())
+	msg = NewAddDidMsg("did:sov:"+ValidDidDoc.Did, ValidDidDoc.PubKey)
+	require.Nil(t, msg.ValidateBasic())
+	
+	// A DID that is not derived from the key cannot be claimed with it
+	msg = NewAddDidMsg("did:ixo:4XJLBfGtWSGKSz4BeRxdun", ValidDidDoc.PubKey)
+	err := msg.ValidateBasic()
+	require.NotNil(t, err)
+	require.Equal(t, CodeDidNotDerivedFromPubKey, int(err.Code()))
+	
+	msg = NewAddDidMsg(ValidDidDoc.Did, "notAKey")
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestAddVerificationKeyMsgValidateBasic(t *testing.T) {
+	key := VerificationKey{
+		Id:       "key-2",
+		PubKey:   "47mm6LCDAyJmqkbUbqGoZKZkBixjBgvDFRMwQRF9HWMU",
+		Purposes: []KeyPurpose{AuthenticationPurpose, AssertionPurpose},
+	}
+	require.Nil(t, NewAddVerificationKeyMsg(ValidDidDoc.Did, key).ValidateBasic())
+	
+	reservedId := key
+	reservedId.Id = PrimaryKeyId
+	require.NotNil(t, NewAddVerificationKeyMsg(ValidDidDoc.Did, reservedId).ValidateBasic())
+	
+	invalidPurpose := key
+	invalidPurpose.Purposes = []KeyPurpose{"keyAgreement"}
+	require.NotNil(t, NewAddVerificationKeyMsg(ValidDidDoc.Did, invalidPurpose).ValidateBasic())
+	
+	invalidPubKey := key
+	invalidPubKey.PubKey = "notAKey"
+	require.NotNil(t, NewAddVerificationKeyMsg(ValidDidDoc.Did, invalidPubKey).ValidateBasic())
+	
+	// A secp256k1 key must be a 33 byte compressed key
+	secp256k1PubKey := secp256k1.GenPrivKeySecp256k1([]byte("secret")).PubKey().(secp256k1.PubKeySecp256k1)
+	secp256k1Key := key
+	secp256k1Key.Type = Secp256k1VerificationKey2019
+	secp256k1Key.PubKey = base58.Encode(secp256k1PubKey[:])
+	require.Nil(t, NewAddVerificationKeyMsg(ValidDidDoc.Did, secp256k1Key).ValidateBasic())
+	
+	mistypedKey := key
+	mistypedKey.Type = Secp256k1VerificationKey2019
+	require.NotNil(t, NewAddVerificationKeyMsg(ValidDidDoc.Did, mistypedKey).ValidateBasic())
+	
+	unsupportedType := key
+	unsupportedType.Type = "RsaVerificationKey2018"
+	require.NotNil(t, NewAddVerificationKeyMsg(ValidDidDoc.Did, unsupportedType).ValidateBasic())
+}
+
+func TestAddServiceMsgValidateBasic(t *testing.T) {
+	service := Service{Id: "cellnode", Type: "CellNode", ServiceEndpoint: "https://cellnode.ixo.world"}
+	require.Nil(t, NewAddServiceMsg(ValidDidDoc.Did, service).ValidateBasic())
+	
+	invalidId := service
+	invalidId.Id = "#cellnode"
+	require.NotNil(t, NewAddServiceMsg(ValidDidDoc.Did, invalidId).ValidateBasic())
+	
+	invalidEndpoint := service
+	invalidEndpoint.ServiceEndpoint = "cellnode.ixo.world"
+	require.NotNil(t, NewAddServiceMsg(ValidDidDoc.Did, invalidEndpoint).ValidateBasic())
+}
+
+func TestSetKeyAgreementKeyMsgValidateBasic(t *testing.T) {
+	keyAgreementKey := "FWNwGaM51hqoutyrY1vAcYqpEnC4ucbz8JDBHjBefLDC"
+	require.Nil(t, NewSetKeyAgreementKeyMsg(ValidDidDoc.Did, keyAgreementKey).ValidateBasic())
+	
+	// An empty key removes the published key
+	require.Nil(t, NewSetKeyAgreementKeyMsg(ValidDidDoc.Did, "").ValidateBasic())
+	
+	err := NewSetKeyAgreementKeyMsg(ValidDidDoc.Did, "notAKey").ValidateBasic()
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidKeyAgreementKey, int(err.Code()))
+	
+	// A new DID doc can publish the key too
+	msg := NewAddDidMsg(ValidDidDoc.Did, ValidDidDoc.PubKey)
+	msg.DidDoc.KeyAgreementKey = keyAgreementKey
+	require.Nil(t, msg.ValidateBasic())
+	msg.DidDoc.KeyAgreementKey = "notAKey"
+	require.NotNil(t, msg.ValidateBasic())
+}
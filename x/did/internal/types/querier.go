@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+const (
+	DefaultDidsPerPage uint64 = 30
+	MaxDidsPerPage     uint64 = 100
+)
+
+// QueryDidsParams selects DIDs that hold a credential of a type and that were
+// created after a block height. Empty criteria match every DID. Results are
+// ordered by DID and start after the After DID, if any, so the NextKey of a
+// result can be passed as After to fetch the following page. This should be
+// preferred to Page, since the DIDs of skipped pages are still scanned.
+// DIDs created before creation heights were recorded never match
+// CreatedAfter.
+type QueryDidsParams struct {
+	CredentialType string  `json:"credentialType" yaml:"credentialType"`
+	CreatedAfter   int64   `json:"createdAfter" yaml:"createdAfter"`
+	After          ixo.Did `json:"after" yaml:"after"`
+	Page           uint64  `json:"page" yaml:"page"`
+	Limit          uint64  `json:"limit" yaml:"limit"`
+}
+
+func NewQueryDidsParams(credentialType string, createdAfter int64, after ixo.Did,
+	page, limit uint64) QueryDidsParams {
+	return QueryDidsParams{
+		CredentialType: credentialType,
+		CreatedAfter:   createdAfter,
+		After:          after,
+		Page:           page,
+		Limit:          limit,
+	}
+}
+
+func (p QueryDidsParams) ValidatePagination() error {
+	if p.Page == 0 {
+		return fmt.Errorf("page must be positive")
+	} else if p.Limit == 0 {
+		return fmt.Errorf("limit must be positive")
+	} else if p.Limit > MaxDidsPerPage {
+		return fmt.Errorf("limit must not exceed %d", MaxDidsPerPage)
+	} else if !ixo.ValidPage(p.Page, p.Limit) {
+		return fmt.Errorf("page must not exceed %d", ixo.MaxPage(p.Limit))
+	}
+	return nil
+}
+
+func (p QueryDidsParams) ValidateFilters() error {
+	if p.CreatedAfter < 0 {
+		return fmt.Errorf("created after height must not be negative")
+	}
+	return nil
+}
+
+// QueryDidsResult holds a page of DIDs. NextKey is empty on the last page.
+type QueryDidsResult struct {
+	Page    uint64    `json:"page" yaml:"page"`
+	Limit   uint64    `json:"limit" yaml:"limit"`
+	NextKey ixo.Did   `json:"nextKey" yaml:"nextKey"`
+	Dids    []ixo.Did `json:"dids" yaml:"dids"`
+}
+
+// QueryDidDocsResult holds a page of DID docs. NextKey is empty on the last
+// page.
+type QueryDidDocsResult struct {
+	Page    uint64       `json:"page" yaml:"page"`
+	Limit   uint64       `json:"limit" yaml:"limit"`
+	NextKey ixo.Did      `json:"nextKey" yaml:"nextKey"`
+	DidDocs []BaseDidDoc `json:"didDocs" yaml:"didDocs"`
+}
+
+type QueryDidCountResult struct {
+	Count uint64 `json:"count" yaml:"count"`
+}
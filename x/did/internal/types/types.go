@@ -2,6 +2,14 @@ package types
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 	
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
@@ -9,9 +17,196 @@ import (
 var _ ixo.DidDoc = (*BaseDidDoc)(nil)
 
 type BaseDidDoc struct {
-	Did         ixo.Did         `json:"did"`
-	PubKey      string          `json:"pubKey"`
-	Credentials []DidCredential `json:"credentials"`
+	Did              ixo.Did           `json:"did"`
+	PubKey           string            `json:"pubKey"`
+	Credentials      []DidCredential   `json:"credentials"`
+	VerificationKeys []VerificationKey `json:"verificationKeys,omitempty"`
+	Services         []Service         `json:"services,omitempty"`
+	Controllers      []ixo.Did         `json:"controllers,omitempty"`
+	Delegations      []Delegation      `json:"delegations,omitempty"`
+	KeyAgreementKey  string            `json:"keyAgreementKey,omitempty"`
+}
+
+// Delegation lets the delegate DID sign msgs of the given types on behalf of
+// the DID until the expiry, if any. Msg types are named as by
+// ixo.MsgTypeName, and a type of <route>/* covers all msgs of a module.
+// Delegations never cover the UndelegableMsgTypes.
+type Delegation struct {
+	DelegateDid ixo.Did   `json:"delegateDid"`
+	MsgTypes    []string  `json:"msgTypes"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// UndelegableMsgTypes change who can sign on behalf of a DID, so only the
+// DID's own keys and its controllers can sign them. A delegate could
+// otherwise widen its own delegation, or make itself a controller.
+var UndelegableMsgTypes = map[string]bool{
+	"did/AddControllerMsg":         true,
+	"did/RemoveControllerMsg":      true,
+	"did/SetDelegationMsg":         true,
+	"did/RemoveDelegationMsg":      true,
+	"did/AddVerificationKeyMsg":    true,
+	"did/RemoveVerificationKeyMsg": true,
+}
+
+func (d Delegation) Validate() sdk.Error {
+	if d.DelegateDid == "" {
+		return ErrorInvalidDelegation(DefaultCodeSpace, "delegate did should not be empty")
+	} else if len(d.MsgTypes) == 0 {
+		return ErrorInvalidDelegation(DefaultCodeSpace, "msg types should not be empty")
+	}
+	
+	for _, msgType := range d.MsgTypes {
+		parts := strings.Split(msgType, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return ErrorInvalidDelegation(DefaultCodeSpace, fmt.Sprintf("invalid msg type %s", msgType))
+		} else if UndelegableMsgTypes[msgType] {
+			return ErrorInvalidDelegation(DefaultCodeSpace, fmt.Sprintf("msg type %s cannot be delegated", msgType))
+		}
+	}
+	
+	return nil
+}
+
+// Covers returns true if the delegation allows signing the msg type at the time
+func (d Delegation) Covers(msgType string, now time.Time) bool {
+	if UndelegableMsgTypes[msgType] {
+		return false
+	} else if !d.Expiry.IsZero() && !now.Before(d.Expiry) {
+		return false
+	}
+	
+	route := strings.SplitN(msgType, "/", 2)[0]
+	for _, delegated := range d.MsgTypes {
+		if delegated == msgType || delegated == route+"/*" {
+			return true
+		}
+	}
+	
+	return false
+}
+
+// PrimaryKeyId is the id of the PubKey of a DID doc. Additional verification
+// keys cannot use it.
+const PrimaryKeyId = "key-1"
+
+type KeyPurpose string
+
+const (
+	AuthenticationPurpose KeyPurpose = "authentication"
+	AssertionPurpose      KeyPurpose = "assertionMethod"
+)
+
+func (purpose KeyPurpose) IsValid() bool {
+	return purpose == AuthenticationPurpose || purpose == AssertionPurpose
+}
+
+// VerificationKey is an additional key of a DID doc. Keys with the
+// authentication purpose can sign txs on behalf of the DID. The key is an
+// ed25519 key unless its type is EcdsaSecp256k1VerificationKey2019, in which
+// case it is a compressed secp256k1 key, such as one of the keyring. Both are
+// base58 encoded.
+type VerificationKey struct {
+	Id       string       `json:"id"`
+	PubKey   string       `json:"pubKey"`
+	Purposes []KeyPurpose `json:"purposes"`
+	Type     string       `json:"type,omitempty"`
+}
+
+// KeyType returns the verification method type of the key
+func (vk VerificationKey) KeyType() string {
+	if vk.Type == "" {
+		return Ed25519VerificationKey2018
+	}
+	return vk.Type
+}
+
+// GetPubKey returns the key as a tendermint public key. It returns false if
+// the key does not match its type.
+func (vk VerificationKey) GetPubKey() (crypto.PubKey, bool) {
+	switch vk.KeyType() {
+	case Ed25519VerificationKey2018:
+		if _, ok := ixo.DidFromPubKey(vk.PubKey); !ok {
+			return nil, false
+		}
+		return ixo.Ed25519PubKey(vk.PubKey), true
+	case Secp256k1VerificationKey2019:
+		return ixo.Secp256k1PubKey(vk.PubKey)
+	default:
+		return nil, false
+	}
+}
+
+func (vk VerificationKey) HasPurpose(purpose KeyPurpose) bool {
+	for _, p := range vk.Purposes {
+		if p == purpose {
+			return true
+		}
+	}
+	
+	return false
+}
+
+func (vk VerificationKey) Validate() sdk.Error {
+	if err := validateFragmentId(vk.Id); err != nil {
+		return ErrorInvalidVerificationKey(DefaultCodeSpace, err.Error())
+	} else if vk.Id == PrimaryKeyId {
+		return ErrorInvalidVerificationKey(DefaultCodeSpace, fmt.Sprintf("id %s is reserved for the pubKey", PrimaryKeyId))
+	} else if vk.KeyType() != Ed25519VerificationKey2018 && vk.KeyType() != Secp256k1VerificationKey2019 {
+		return ErrorInvalidVerificationKey(DefaultCodeSpace, fmt.Sprintf("unsupported key type %s", vk.Type))
+	} else if _, ok := vk.GetPubKey(); !ok {
+		return ErrorInvalidVerificationKey(DefaultCodeSpace, fmt.Sprintf("pubKey must be a base58 encoded %s key", vk.KeyType()))
+	} else if len(vk.Purposes) == 0 {
+		return ErrorInvalidVerificationKey(DefaultCodeSpace, "purposes should not be empty")
+	}
+	
+	for _, purpose := range vk.Purposes {
+		if !purpose.IsValid() {
+			return ErrorInvalidVerificationKey(DefaultCodeSpace, fmt.Sprintf("invalid purpose %s", purpose))
+		}
+	}
+	
+	return nil
+}
+
+func (s Service) Validate() sdk.Error {
+	if err := validateFragmentId(s.Id); err != nil {
+		return ErrorInvalidService(DefaultCodeSpace, err.Error())
+	} else if strings.TrimSpace(s.Type) == "" {
+		return ErrorInvalidService(DefaultCodeSpace, "type should not be empty")
+	}
+	
+	endpoint, err := url.Parse(s.ServiceEndpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return ErrorInvalidService(DefaultCodeSpace, "serviceEndpoint must be an http(s) URL")
+	}
+	
+	return nil
+}
+
+// KeyAgreementKeyId is the id of the key agreement key of a DID doc
+const KeyAgreementKeyId = "key-agreement-1"
+
+// ValidateKeyAgreementKey checks that the key agreement key is a base58
+// encoded curve25519 public key, such as the EncryptionPublicKey of a
+// sovrin DID
+func ValidateKeyAgreementKey(key string) sdk.Error {
+	if len(base58.Decode(key)) != 32 {
+		return ErrorInvalidKeyAgreementKey(DefaultCodeSpace, "key agreement key must be a base58 encoded curve25519 key")
+	}
+	
+	return nil
+}
+
+// validateFragmentId checks an id that is appended to the DID as a fragment
+func validateFragmentId(id string) error {
+	if strings.TrimSpace(id) == "" {
+		return errors.New("id should not be empty")
+	} else if strings.ContainsAny(id, "#/?: ") {
+		return fmt.Errorf("id %s must not contain '#', '/', '?', ':' or spaces", id)
+	}
+	
+	return nil
 }
 
 type DidCredential struct {
@@ -34,10 +229,26 @@ func (dd BaseDidDoc) GetCredentials() []DidCredential { return dd.Credentials }
 
 func InitDidDoc(did ixo.Did, pubKey string) BaseDidDoc {
 	return BaseDidDoc{
-		did,
-		pubKey,
-		make([]DidCredential, 0),
+		Did:         did,
+		PubKey:      pubKey,
+		Credentials: make([]DidCredential, 0),
+	}
+}
+
+// GetAuthenticationKeys returns the PubKey and the verification keys with the
+// authentication purpose, which can all sign txs on behalf of the DID
+func (dd BaseDidDoc) GetAuthenticationKeys() []crypto.PubKey {
+	pubKeys := []crypto.PubKey{ixo.Ed25519PubKey(dd.PubKey)}
+	for _, key := range dd.VerificationKeys {
+		if !key.HasPurpose(AuthenticationPurpose) {
+			continue
+		}
+		if pubKey, ok := key.GetPubKey(); ok {
+			pubKeys = append(pubKeys, pubKey)
+		}
 	}
+	
+	return pubKeys
 }
 
 func (dd BaseDidDoc) SetDid(did ixo.Did) error {
@@ -68,6 +279,141 @@ func (dd *BaseDidDoc) AddCredential(cred DidCredential) {
 	dd.Credentials = append(dd.Credentials, cred)
 }
 
+// HasCredentialType returns true if one of the credentials added to the DID
+// doc is of the type
+func (dd BaseDidDoc) HasCredentialType(credType string) bool {
+	for _, cred := range dd.Credentials {
+		for _, t := range cred.CredType {
+			if t == credType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DidMigrationReport lists the stored DID docs whose DID is not derived
+// from their public key, and so would be rejected if created today
+type DidMigrationReport struct {
+	TotalDidDocs uint64         `json:"totalDidDocs"`
+	Underived    []UnderivedDid `json:"underived"`
+}
+
+type UnderivedDid struct {
+	Did        ixo.Did `json:"did"`
+	PubKey     string  `json:"pubKey"`
+	DerivedDid ixo.Did `json:"derivedDid"`
+}
+
+func (dd *BaseDidDoc) AddVerificationKey(key VerificationKey) sdk.Error {
+	if key.PubKey == dd.PubKey {
+		return ErrorInvalidVerificationKey(DefaultCodeSpace, "pubKey is already the DID doc's pubKey")
+	}
+	
+	for _, existing := range dd.VerificationKeys {
+		if existing.Id == key.Id {
+			return ErrorInvalidVerificationKey(DefaultCodeSpace, fmt.Sprintf("key %s already exists", key.Id))
+		} else if existing.PubKey == key.PubKey {
+			return ErrorInvalidVerificationKey(DefaultCodeSpace, fmt.Sprintf("pubKey already added as %s", existing.Id))
+		}
+	}
+	
+	dd.VerificationKeys = append(dd.VerificationKeys, key)
+	
+	return nil
+}
+
+func (dd *BaseDidDoc) RemoveVerificationKey(keyId string) sdk.Error {
+	for i, existing := range dd.VerificationKeys {
+		if existing.Id == keyId {
+			dd.VerificationKeys = append(dd.VerificationKeys[:i], dd.VerificationKeys[i+1:]...)
+			return nil
+		}
+	}
+	
+	return ErrorInvalidVerificationKey(DefaultCodeSpace, fmt.Sprintf("key %s does not exist", keyId))
+}
+
+func (dd *BaseDidDoc) AddService(service Service) sdk.Error {
+	for _, existing := range dd.Services {
+		if existing.Id == service.Id {
+			return ErrorInvalidService(DefaultCodeSpace, fmt.Sprintf("service %s already exists", service.Id))
+		}
+	}
+	
+	dd.Services = append(dd.Services, service)
+	
+	return nil
+}
+
+func (dd *BaseDidDoc) RemoveService(serviceId string) sdk.Error {
+	for i, existing := range dd.Services {
+		if existing.Id == serviceId {
+			dd.Services = append(dd.Services[:i], dd.Services[i+1:]...)
+			return nil
+		}
+	}
+	
+	return ErrorInvalidService(DefaultCodeSpace, fmt.Sprintf("service %s does not exist", serviceId))
+}
+
+func (dd *BaseDidDoc) AddController(controllerDid ixo.Did) sdk.Error {
+	if ixo.UnprefixedDid(controllerDid) == ixo.UnprefixedDid(dd.Did) {
+		return ErrorInvalidController(DefaultCodeSpace, "a DID cannot be its own controller")
+	}
+	
+	for _, existing := range dd.Controllers {
+		if existing == controllerDid {
+			return ErrorInvalidController(DefaultCodeSpace, fmt.Sprintf("%s is already a controller", controllerDid))
+		}
+	}
+	
+	dd.Controllers = append(dd.Controllers, controllerDid)
+	
+	return nil
+}
+
+func (dd *BaseDidDoc) RemoveController(controllerDid ixo.Did) sdk.Error {
+	for i, existing := range dd.Controllers {
+		if existing == controllerDid {
+			dd.Controllers = append(dd.Controllers[:i], dd.Controllers[i+1:]...)
+			return nil
+		}
+	}
+	
+	return ErrorInvalidController(DefaultCodeSpace, fmt.Sprintf("%s is not a controller", controllerDid))
+}
+
+// SetDelegation adds the delegation, replacing any existing delegation to the
+// same delegate
+func (dd *BaseDidDoc) SetDelegation(delegation Delegation) sdk.Error {
+	if ixo.UnprefixedDid(delegation.DelegateDid) == ixo.UnprefixedDid(dd.Did) {
+		return ErrorInvalidDelegation(DefaultCodeSpace, "a DID cannot delegate to itself")
+	}
+	
+	for i, existing := range dd.Delegations {
+		if existing.DelegateDid == delegation.DelegateDid {
+			dd.Delegations[i] = delegation
+			return nil
+		}
+	}
+	
+	dd.Delegations = append(dd.Delegations, delegation)
+	
+	return nil
+}
+
+func (dd *BaseDidDoc) RemoveDelegation(delegateDid ixo.Did) sdk.Error {
+	for i, existing := range dd.Delegations {
+		if existing.DelegateDid == delegateDid {
+			dd.Delegations = append(dd.Delegations[:i], dd.Delegations[i+1:]...)
+			return nil
+		}
+	}
+	
+	return ErrorInvalidDelegation(DefaultCodeSpace, fmt.Sprintf("no delegation to %s", delegateDid))
+}
+
 type DidMsg interface {
 	IsNewDid() bool
 }
@@ -57,10 +57,20 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	didTxCmd.AddCommand(client.PostCommands(
+	didTxCmd.AddCommand(client.PostCommands(cli.FromDidCommands(
 		cli.AddDidDocCmd(cdc),
 		cli.AddCredentialCmd(cdc),
-	)...)
+		cli.AddServiceCmd(cdc),
+		cli.RemoveServiceCmd(cdc),
+		cli.AddVerificationKeyCmd(cdc),
+		cli.RemoveVerificationKeyCmd(cdc),
+		cli.AddVerifiableCredentialCmd(cdc),
+		cli.AddControllerCmd(cdc),
+		cli.RemoveControllerCmd(cdc),
+		cli.SetDelegationCmd(cdc),
+		cli.RemoveDelegationCmd(cdc),
+		cli.SetKeyAgreementKeyCmd(cdc),
+	)...)...)
 
 	return didTxCmd
 }
@@ -77,8 +87,15 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	didQueryCmd.AddCommand(client.GetCommands(
 		cli.GetAddressFromDidCmd(),
 		cli.GetDidDocCmd(cdc),
+		cli.GetDidDocumentCmd(cdc),
 		cli.GetAllDidsCmd(cdc),
 		cli.GetAllDidDocsCmd(cdc),
+		cli.GetDidCountCmd(cdc),
+		cli.GetDidMigrationReportCmd(cdc),
+		cli.GetCredentialCmd(cdc),
+		cli.GetCredentialsBySubjectCmd(cdc),
+		cli.GetCredentialsByIssuerCmd(cdc),
+		cli.GetCredentialsByTypeCmd(cdc),
 	)...)
 
 	return didQueryCmd
@@ -130,6 +147,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abciTypes.RequestBeginBlock) {
+	am.keeper.MigrateStore(ctx)
 }
 
 func (AppModule) EndBlock(_ sdk.Context, _ abciTypes.RequestEndBlock) []abciTypes.ValidatorUpdate {
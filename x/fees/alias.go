@@ -11,18 +11,23 @@ const (
 	RouterKey    = types.RouterKey
 	StoreKey     = types.StoreKey
 	
+	DefaultParamspace = types.DefaultParamspace
+	
 	DefaultCodeSpace = types.DefaultCodeSpace
 )
 
 type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
+	Params       = types.Params
+	Fee          = types.Fee
 	FeeType      = types.FeeType
 )
 
 var (
 	NewKeeper                               = keeper.NewKeeper
 	ModuleCdc                               = types.ModuleCdc
+	ErrInvalidParams                        = types.ErrInvalidParams
 	NewQuerier                              = keeper.NewQuerier
 	KeyIxoFactor                            = types.KeyIxoFactor
 	KeyNodeFeePercentage                    = types.KeyNodeFeePercentage
@@ -36,6 +41,13 @@ var (
 	KeyEvaluationAgentRegistrationFeeAmount = types.KeyEvaluationAgentRegistrationFeeAmount
 	KeyEvaluationPayFeePercentage           = types.KeyEvaluationPayFeePercentage
 	KeyEvaluationPayNodeFeePercentage       = types.KeyEvaluationPayNodeFeePercentage
+	KeyIxoFeesToCommunityPool               = types.KeyIxoFeesToCommunityPool
 	
+	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesis
+	ValidateGenesis     = types.ValidateGenesis
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+	ParamKeyTable       = types.ParamKeyTable
+	NewFee              = types.NewFee
 )
@@ -26,7 +26,7 @@ func GetFeesRequestHandler(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			fees := make(map[string]int64)
+			var fees []types.Fee
 			err = cliCtx.Codec.UnmarshalJSON(bz, &fees)
 			if err != nil {
 				return err
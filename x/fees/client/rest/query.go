@@ -23,7 +23,7 @@ func queryFeesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 		
-		fees := make(map[string]int64)
+		var fees []types.Fee
 		err = cliCtx.Codec.UnmarshalJSON(bz, &fees)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -24,10 +24,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 }
 
 func queryFees(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
-	fees := make(map[string]int64)
+	var fees []types.Fee
 	for _, feeKey := range types.AllFees {
-		resDec := k.GetDec(ctx, feeKey)
-		fees[feeKey] = resDec.RoundInt64()
+		fees = append(fees, types.NewFee(feeKey, k.GetDec(ctx, feeKey)))
 	}
 	
 	res, err := codec.MarshalJSONIndent(k.cdc, fees)
@@ -8,6 +8,7 @@ const (
 	DefaultCodeSpace    types.CodespaceType = ModuleName
 	CodeInvalidFeeQuery                     = 301
 	CodeUnmarshal                           = 302
+	CodeInvalidParams                       = 303
 )
 
 func ErrorInvalidFeeQuery() types.Error {
@@ -19,3 +20,7 @@ func ErrorUnmarshalFees() types.Error {
 	return types.NewError(DefaultCodeSpace, CodeUnmarshal,
 		"Error occurred while unmarshal the fees data")
 }
+
+func ErrInvalidParams(msg string) types.Error {
+	return types.NewError(DefaultCodeSpace, CodeInvalidParams, msg)
+}
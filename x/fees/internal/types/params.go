@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+const DefaultParamspace = ModuleName
+
+// Params are the fees charged by the project module. They are stored in a
+// params subspace keyed by the fee keys (e.g. KeyClaimFeeAmount), so each of
+// them can be changed through a gov ParameterChangeProposal.
+type Params struct {
+	IxoFactor sdk.Dec `json:"ixoFactor" yaml:"ixoFactor"`
+	
+	InitiationFeeAmount         sdk.Dec `json:"initiationFeeAmount" yaml:"initiationFeeAmount"`
+	InitiationNodeFeePercentage sdk.Dec `json:"initiationNodeFeePercentage" yaml:"initiationNodeFeePercentage"`
+	
+	ClaimFeeAmount      sdk.Dec `json:"claimFeeAmount" yaml:"claimFeeAmount"`
+	EvaluationFeeAmount sdk.Dec `json:"evaluationFeeAmount" yaml:"evaluationFeeAmount"`
+	
+	ServiceAgentRegistrationFeeAmount    sdk.Dec `json:"serviceAgentRegistrationFeeAmount" yaml:"serviceAgentRegistrationFeeAmount"`
+	EvaluationAgentRegistrationFeeAmount sdk.Dec `json:"evaluationAgentRegistrationFeeAmount" yaml:"evaluationAgentRegistrationFeeAmount"`
+	
+	NodeFeePercentage sdk.Dec `json:"nodeFeePercentage" yaml:"nodeFeePercentage"`
+	
+	EvaluationPayFeePercentage     sdk.Dec `json:"evaluationPayFeePercentage" yaml:"evaluationPayFeePercentage"`
+	EvaluationPayNodeFeePercentage sdk.Dec `json:"evaluationPayNodeFeePercentage" yaml:"evaluationPayNodeFeePercentage"`
+	
+	// IxoFeesToCommunityPool sends the ixo share of claim and evaluation fees
+	// to the community pool instead of the project's ixo fees account
+	IxoFeesToCommunityPool bool `json:"ixoFeesToCommunityPool" yaml:"ixoFeesToCommunityPool"`
+}
+
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(ixoFactor, initiationFeeAmount, initiationNodeFeePercentage,
+	claimFeeAmount, evaluationFeeAmount, serviceAgentRegistrationFeeAmount,
+	evaluationAgentRegistrationFeeAmount, nodeFeePercentage,
+	evaluationPayFeePercentage, evaluationPayNodeFeePercentage sdk.Dec,
+	ixoFeesToCommunityPool bool) Params {
+	return Params{
+		IxoFactor:                            ixoFactor,
+		InitiationFeeAmount:                  initiationFeeAmount,
+		InitiationNodeFeePercentage:          initiationNodeFeePercentage,
+		ClaimFeeAmount:                       claimFeeAmount,
+		EvaluationFeeAmount:                  evaluationFeeAmount,
+		ServiceAgentRegistrationFeeAmount:    serviceAgentRegistrationFeeAmount,
+		EvaluationAgentRegistrationFeeAmount: evaluationAgentRegistrationFeeAmount,
+		NodeFeePercentage:                    nodeFeePercentage,
+		EvaluationPayFeePercentage:           evaluationPayFeePercentage,
+		EvaluationPayNodeFeePercentage:       evaluationPayNodeFeePercentage,
+		IxoFeesToCommunityPool:               ixoFeesToCommunityPool,
+	}
+}
+
+func DefaultParams() Params {
+	ixoFactor := sdk.OneDec() // 1
+	
+	initiationFeeAmount := sdk.NewDec(500).Mul(ixo.IxoDecimals) // 500
+	initiationNodeFeePercentage := sdk.ZeroDec()                // 0
+	
+	claimFeeAmount := sdk.NewDec(6).Quo(sdk.NewDec(10)).Mul(ixo.IxoDecimals)      // 0.6
+	evaluationFeeAmount := sdk.NewDec(4).Quo(sdk.NewDec(10)).Mul(ixo.IxoDecimals) // 0.4
+	
+	serviceAgentRegistrationFeeAmount := sdk.ZeroDec().Mul(ixo.IxoDecimals)    // 0
+	evaluationAgentRegistrationFeeAmount := sdk.ZeroDec().Mul(ixo.IxoDecimals) // 0
+	
+	nodeFeePercentage := sdk.NewDec(5).Quo(sdk.NewDec(10)) // 0.5
+	
+	evaluationPayFeePercentage := sdk.NewDec(1).Quo(sdk.NewDec(10))     // 0.1
+	evaluationPayNodeFeePercentage := sdk.NewDec(2).Quo(sdk.NewDec(10)) // 0.2
+	
+	ixoFeesToCommunityPool := false
+	
+	return NewParams(ixoFactor, initiationFeeAmount, initiationNodeFeePercentage,
+		claimFeeAmount, evaluationFeeAmount, serviceAgentRegistrationFeeAmount,
+		evaluationAgentRegistrationFeeAmount, nodeFeePercentage,
+		evaluationPayFeePercentage, evaluationPayNodeFeePercentage,
+		ixoFeesToCommunityPool)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: []byte(KeyIxoFactor), Value: &p.IxoFactor},
+		{Key: []byte(KeyInitiationFeeAmount), Value: &p.InitiationFeeAmount},
+		{Key: []byte(KeyInitiationNodeFeePercentage), Value: &p.InitiationNodeFeePercentage},
+		{Key: []byte(KeyClaimFeeAmount), Value: &p.ClaimFeeAmount},
+		{Key: []byte(KeyEvaluationFeeAmount), Value: &p.EvaluationFeeAmount},
+		{Key: []byte(KeyServiceAgentRegistrationFeeAmount), Value: &p.ServiceAgentRegistrationFeeAmount},
+		{Key: []byte(KeyEvaluationAgentRegistrationFeeAmount), Value: &p.EvaluationAgentRegistrationFeeAmount},
+		{Key: []byte(KeyNodeFeePercentage), Value: &p.NodeFeePercentage},
+		{Key: []byte(KeyEvaluationPayFeePercentage), Value: &p.EvaluationPayFeePercentage},
+		{Key: []byte(KeyEvaluationPayNodeFeePercentage), Value: &p.EvaluationPayNodeFeePercentage},
+		{Key: []byte(KeyIxoFeesToCommunityPool), Value: &p.IxoFeesToCommunityPool},
+	}
+}
+
+// Validate checks that all fees are set and non-negative, that the ixo factor
+// is positive, and that percentages (which are fractions) are at most 1
+func (p Params) Validate() error {
+	fees := map[string]sdk.Dec{
+		KeyIxoFactor:                            p.IxoFactor,
+		KeyInitiationFeeAmount:                  p.InitiationFeeAmount,
+		KeyInitiationNodeFeePercentage:          p.InitiationNodeFeePercentage,
+		KeyClaimFeeAmount:                       p.ClaimFeeAmount,
+		KeyEvaluationFeeAmount:                  p.EvaluationFeeAmount,
+		KeyServiceAgentRegistrationFeeAmount:    p.ServiceAgentRegistrationFeeAmount,
+		KeyEvaluationAgentRegistrationFeeAmount: p.EvaluationAgentRegistrationFeeAmount,
+		KeyNodeFeePercentage:                    p.NodeFeePercentage,
+		KeyEvaluationPayFeePercentage:           p.EvaluationPayFeePercentage,
+		KeyEvaluationPayNodeFeePercentage:       p.EvaluationPayNodeFeePercentage,
+	}
+	
+	for _, key := range AllFees {
+		fee := fees[key]
+		if fee.Int == nil {
+			return fmt.Errorf("fee %s is not set", key)
+		} else if fee.IsNegative() {
+			return fmt.Errorf("fee %s cannot be negative: %s", key, fee)
+		} else if strings.HasSuffix(key, "Percentage") && fee.GT(sdk.OneDec()) {
+			return fmt.Errorf("fee %s cannot be greater than 1: %s", key, fee)
+		}
+	}
+	
+	if !p.IxoFactor.IsPositive() {
+		return fmt.Errorf("fee %s must be positive: %s", KeyIxoFactor, p.IxoFactor)
+	}
+	
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	negativeFee := DefaultParams()
+	negativeFee.ClaimFeeAmount = sdk.NewDec(-1)
+	require.Error(t, negativeFee.Validate())
+
+	percentageAboveOne := DefaultParams()
+	percentageAboveOne.NodeFeePercentage = sdk.NewDec(2)
+	require.Error(t, percentageAboveOne.Validate())
+
+	zeroIxoFactor := DefaultParams()
+	zeroIxoFactor.IxoFactor = sdk.ZeroDec()
+	require.Error(t, zeroIxoFactor.Validate())
+
+	missingFee := DefaultParams()
+	missingFee.EvaluationFeeAmount = sdk.Dec{}
+	require.Error(t, missingFee.Validate())
+}
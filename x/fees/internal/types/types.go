@@ -1,7 +1,11 @@
 package types
 
 import (
+	"strings"
+	
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
 
 type FeeType string
@@ -31,6 +35,8 @@ const KeyEvaluationPayFeePercentage = "EvaluationPayFeePercentage"
 
 const KeyEvaluationPayNodeFeePercentage = "EvaluationPayNodeFeePercentage"
 
+const KeyIxoFeesToCommunityPool = "IxoFeesToCommunityPool"
+
 var AllFees = []string{
 	KeyIxoFactor,
 	KeyInitiationFeeAmount,
@@ -44,20 +50,34 @@ var AllFees = []string{
 	KeyEvaluationPayNodeFeePercentage,
 }
 
-type GenesisState struct {
-	IxoFactor sdk.Dec `json:"ixoFactor"`
-	
-	InitiationFeeAmount         sdk.Dec `json:"initiationFeeAmount"`
-	InitiationNodeFeePercentage sdk.Dec `json:"initiationNodeFeePercentage"`
-	
-	ClaimFeeAmount      sdk.Dec `json:"claimFeeAmount"`
-	EvaluationFeeAmount sdk.Dec `json:"evaluationFeeAmount"`
-	
-	ServiceAgentRegistrationFeeAmount    sdk.Dec `json:"serviceAgentRegistrationFeeAmount"`
-	EvaluationAgentRegistrationFeeAmount sdk.Dec `json:"evaluationAgentRegistrationFeeAmount"`
-	
-	NodeFeePercentage sdk.Dec `json:"nodeFeePercentage"`
-	
-	EvaluationPayFeePercentage     sdk.Dec `json:"evaluationPayFeePercentage"`
-	EvaluationPayNodeFeePercentage sdk.Dec `json:"evaluationPayNodeFeePercentage"`
+const (
+	UnitMultiplier = "multiplier"
+	UnitFraction   = "fraction"
+)
+
+// Fee is the exact value of a fee, along with its unit. Amounts are in the
+// smallest unit of the native token, percentages are fractions of 1.
+type Fee struct {
+	Key   string  `json:"key" yaml:"key"`
+	Value sdk.Dec `json:"value" yaml:"value"`
+	Unit  string  `json:"unit" yaml:"unit"`
+}
+
+func NewFee(key string, value sdk.Dec) Fee {
+	return Fee{
+		Key:   key,
+		Value: value,
+		Unit:  FeeUnit(key),
+	}
+}
+
+func FeeUnit(key string) string {
+	switch {
+	case key == KeyIxoFactor:
+		return UnitMultiplier
+	case strings.HasSuffix(key, "Percentage"):
+		return UnitFraction
+	default:
+		return ixo.IxoNativeToken
+	}
 }
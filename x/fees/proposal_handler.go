@@ -0,0 +1,24 @@
+package fees
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// NewParamChangeProposalHandler wraps the params module's proposal handler so
+// that changes leaving the fees in an invalid state are rejected. Gov applies
+// proposals in a cached context, so a rejected change is never written.
+func NewParamChangeProposalHandler(k Keeper, next gov.Handler) gov.Handler {
+	return func(ctx sdk.Context, content gov.Content) sdk.Error {
+		err := next(ctx, content)
+		if err != nil {
+			return err
+		}
+		
+		if err := k.GetParams(ctx).Validate(); err != nil {
+			return ErrInvalidParams(err.Error())
+		}
+		
+		return nil
+	}
+}
@@ -0,0 +1,66 @@
+package ixo
+
+import (
+	"strings"
+	
+	"github.com/btcsuite/btcutil/base58"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/ed25519"
+)
+
+const (
+	DidPrefixIxo = "did:ixo:"
+	DidPrefixSov = "did:sov:"
+)
+
+// UnprefixedDid strips the did:ixo: or did:sov: method prefix from a DID
+func UnprefixedDid(did Did) string {
+	for _, prefix := range []string{DidPrefixIxo, DidPrefixSov} {
+		if strings.HasPrefix(did, prefix) {
+			return did[len(prefix):]
+		}
+	}
+	return did
+}
+
+// PrefixedDid adds the did:ixo: method prefix to a DID that has no prefix
+func PrefixedDid(did Did) Did {
+	if UnprefixedDid(did) != did {
+		return did
+	}
+	return DidPrefixIxo + did
+}
+
+// DidFromPubKey derives the unprefixed DID of a base58 encoded ed25519 verify
+// key, the same way as sovrin.FromSeed, i.e. base58(verifyKey[:16])
+func DidFromPubKey(pubKey string) (Did, bool) {
+	pubKeyBytes := base58.Decode(pubKey)
+	if len(pubKeyBytes) != ed25519.PublicKeySize {
+		return "", false
+	}
+	return base58.Encode(pubKeyBytes[:16]), true
+}
+
+// IsDidDerivedFromPubKey returns true if the DID, with or without a method
+// prefix, is the one derived from the base58 encoded verify key
+func IsDidDerivedFromPubKey(did Did, pubKey string) bool {
+	derivedDid, ok := DidFromPubKey(pubKey)
+	return ok && UnprefixedDid(did) == derivedDid
+}
+
+// DidToAddr returns the account address of a DID. Every module derives
+// addresses this way, so that a DID has the same account everywhere.
+func DidToAddr(did Did) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(did)))
+}
+
+// DidAccountToAddr returns the address of a named account held by a DID, such
+// as a project's fee accounts, which is the address of the DID URL
+// did/accountId. The account named after the DID itself is the DID's own.
+func DidAccountToAddr(did Did, accountId string) sdk.AccAddress {
+	if accountId == did {
+		return DidToAddr(did)
+	}
+	return DidToAddr(did + "/" + accountId)
+}
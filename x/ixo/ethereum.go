@@ -6,15 +6,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"math/big"
 	"os"
 	"regexp"
 	"strings"
 	
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/sha3"
@@ -22,13 +23,14 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	ethAuth "github.com/ixofoundation/ixo-go-abi/abi/auth"
 	ethProject "github.com/ixofoundation/ixo-go-abi/abi/project"
-	
-	"github.com/ixofoundation/ixo-cosmos/x/contracts"
-	"github.com/ixofoundation/ixo-cosmos/x/params"
 )
 
 const ETH_URL = "ETH_URL"
 
+// MinFundingConfirmations is the number of blocks, including its own, that
+// a project funding transfer has to be buried under before it is attested
+const MinFundingConfirmations = 12
+
 var FUNDING_METHOD_HASH = strings.ToLower(GetKeccak("transfer(address,uint256)")[0:8])
 
 type EthTransaction struct {
@@ -52,14 +54,15 @@ func (tx *EthTransaction) UnmarshalJSON(msg []byte) error {
 	return json.Unmarshal(msg, &tx.Result)
 }
 
+// EthClient is used by off-chain processes, such as the withdrawal relayer and
+// the validators' funding attestations, and must never be used in consensus
 type EthClient struct {
 	rpcClient *rpc.Client
 	client    *ethclient.Client
-	k         contracts.Keeper
 	callOpts  bind.CallOpts
 }
 
-func NewEthClient(k contracts.Keeper) (EthClient, error) {
+func NewEthClient() (EthClient, error) {
 	// TODO: REMEMBER TO GET THE TARGET RPC ENDPOINT FROM THE ENVIRONMENT !!!
 	// url := LookupEnv(ETH_URL, "https://api.infura.io/v1/jsonrpc/ropsten")
 	// url := LookupEnv(ETH_URL, "https://ropsten.infura.io/sq19XM5Eu2ANGAzwZ4yk")
@@ -84,7 +87,6 @@ func NewEthClient(k contracts.Keeper) (EthClient, error) {
 	return EthClient{
 		rpcClient,
 		client,
-		k,
 		callOpts,
 	}, nil
 }
@@ -97,27 +99,76 @@ func (c EthClient) GetTransactionByHash(txHash string) (*EthTransaction, error)
 	return tx, err
 }
 
-func (c EthClient) IsProjectFundingTx(ctx sdk.Context, projectDid Did, tx *EthTransaction) bool {
+// GetProjectFunding checks that the transaction is a successful transfer of
+// ixo tokens to the project's wallet, with at least MinFundingConfirmations,
+// and returns the wallet and the amount transferred
+func (c EthClient) GetProjectFunding(ixoTokenContractAddress string, registryContractAddress string,
+	txHash string, projectDid Did) (string, int64, error) {
 	
-	ixoTokenContractAddress := c.k.GetContract(ctx, contracts.KeyIxoTokenContractAddress)
+	tx, err := c.GetTransactionByHash(txHash)
+	if err != nil {
+		return "", 0, err
+	} else if tx == nil {
+		return "", 0, fmt.Errorf("transaction %s not found", txHash)
+	}
 	
 	if strings.ToLower(tx.Result.To) != strings.ToLower(ixoTokenContractAddress) {
-		ctx.Logger().Error("Token contract mismatch. Got " + tx.Result.To + " should be " + ixoTokenContractAddress)
-		return false
+		return "", 0, fmt.Errorf("token contract mismatch. Got %s should be %s", tx.Result.To, ixoTokenContractAddress)
+	}
+	
+	if len(tx.Result.Input) < 10+64*2 {
+		return "", 0, fmt.Errorf("input of transaction %s is too short", txHash)
 	}
 	
 	if strings.ToLower(getMethodHashFromInput(tx.Result.Input)) != FUNDING_METHOD_HASH {
-		ctx.Logger().Error("Method hash mismatch. Got " + getMethodHashFromInput(tx.Result.Input) + " should be " + FUNDING_METHOD_HASH)
-		return false
+		return "", 0, fmt.Errorf("method hash mismatch. Got %s should be %s",
+			getMethodHashFromInput(tx.Result.Input), FUNDING_METHOD_HASH)
+	}
+	
+	projectWallet, err := c.ProjectWalletFromRegistry(registryContractAddress, projectDid)
+	if err != nil {
+		return "", 0, err
+	}
+	
+	recipient := common.HexToAddress(getParamFromInput(tx.Result.Input, 1))
+	if recipient != common.HexToAddress(projectWallet) {
+		return "", 0, fmt.Errorf("recipient mismatch. Got %s should be %s", recipient.Hex(), projectWallet)
+	}
+	
+	mined, succeeded, err := c.GetTransactionStatus(txHash)
+	if err != nil {
+		return "", 0, err
+	} else if !mined || tx.Result.BlockNumber == "" {
+		return "", 0, fmt.Errorf("transaction %s has not been mined", txHash)
+	} else if !succeeded {
+		return "", 0, fmt.Errorf("transaction %s failed", txHash)
+	}
+	
+	confirmations, err := c.getConfirmations(tx)
+	if err != nil {
+		return "", 0, err
+	} else if confirmations < MinFundingConfirmations {
+		return "", 0, fmt.Errorf("transaction %s has %d of %d confirmations", txHash,
+			confirmations, MinFundingConfirmations)
+	}
+	
+	return projectWallet, c.GetFundingAmt(tx), nil
+}
+
+// getConfirmations returns the number of blocks from the block of the mined
+// transaction up to the latest block
+func (c EthClient) getConfirmations(tx *EthTransaction) (int64, error) {
+	txBlock, err := hexutil.DecodeBig(tx.Result.BlockNumber)
+	if err != nil {
+		return 0, err
 	}
 	
-	_, err := c.ProjectWalletFromProjectRegistry(ctx, projectDid)
+	head, err := c.client.HeaderByNumber(context.Background(), nil)
 	if err != nil {
-		ctx.Logger().Error("Could not get Project Wallet for DID " + projectDid)
-		return false
+		return 0, err
 	}
 	
-	return true
+	return new(big.Int).Sub(head.Number, txBlock).Int64() + 1, nil
 }
 
 func getMethodHashFromInput(input string) string {
@@ -133,14 +184,15 @@ func getParamFromInput(input string, paramPos int) string {
 	return param
 }
 
-func (c EthClient) ProjectWalletFromProjectRegistry(ctx sdk.Context, did Did) (string, error) {
+// ProjectWalletFromRegistry looks up the project wallet in the project
+// registry contract at the given address
+func (c EthClient) ProjectWalletFromRegistry(registryContractStr string, did Did) (string, error) {
 	
 	regex := regexp.MustCompile("[^:]+$")
 	
 	var projectDid [32]byte
 	copy(projectDid[:], regex.FindString(did))
 	
-	registryContractStr := c.k.GetContract(ctx, contracts.KeyProjectRegistryContractAddress)
 	registryContract := common.HexToAddress(registryContractStr)
 	
 	projectRegistryContact, err := ethProject.NewProjectWalletRegistry(registryContract, c.client)
@@ -156,36 +208,62 @@ func (c EthClient) ProjectWalletFromProjectRegistry(ctx sdk.Context, did Did) (s
 	return projectWalletAddress.String(), err
 }
 
-func (c EthClient) InitiateTokenTransfer(ctx sdk.Context, pk params.Keeper, senderAddr string, receiverAddr string, amount int64) (bool, [32]byte) {
-	authContractAddress := common.HexToAddress(c.k.GetContract(ctx, contracts.KeyAuthContractAddress))
+// SubmitTokenTransfer asks the auth contract to validate the transfer of
+// tokens from the sender to the receiver, signed with the local validation
+// wallet, and returns the hash of the Ethereum transaction. It must only be
+// called off-chain, by the withdrawal relayer.
+func (c EthClient) SubmitTokenTransfer(authContractStr string, projectWalletAuthoriserAddress string,
+	actionID [32]byte, senderAddr string, receiverAddr string, amount int64) (string, error) {
+	authContractAddress := common.HexToAddress(authContractStr)
 	authContract, err := ethAuth.NewAuthContract(authContractAddress, c.client)
 	if err != nil {
-		return false, [32]byte{}
+		return "", err
 	}
 	
 	validationEthWallet := getValidationEthWallet()
 	privateKey, err := crypto.HexToECDSA(validationEthWallet.PrivateKey)
 	if err != nil {
-		log.Fatal(err)
+		return "", err
 	}
 	transOpts := bind.NewKeyedTransactor(privateKey)
 	transOpts.GasLimit = uint64(2782100)
 	
-	projectWalletAuthoriserAddress := c.k.GetContract(ctx, contracts.KeyProjectWalletAuthoriserContractAddress)
+	txResult, err := authContract.Validate(transOpts, actionID, common.HexToAddress(projectWalletAuthoriserAddress),
+		common.HexToAddress(senderAddr), common.HexToAddress(receiverAddr), big.NewInt(amount))
+	if err != nil {
+		return "", err
+	}
 	
-	nextTxID := getNextTxID(ctx, pk)
-	var debugTxID []byte
-	debugTxID = nextTxID[:]
-	fmt.Println("-------------------\n\n", common.ToHex(debugTxID))
+	return txResult.Hash().Hex(), nil
+}
+
+// IsActionTriggered returns whether the auth contract has performed the
+// transfer of the action ID, by any of the transactions that confirmed it
+func (c EthClient) IsActionTriggered(authContractStr string, actionID [32]byte) (bool, error) {
+	authContract, err := ethAuth.NewAuthContract(common.HexToAddress(authContractStr), c.client)
+	if err != nil {
+		return false, err
+	}
 	
-	txResult, err := authContract.Validate(transOpts, nextTxID, common.HexToAddress(projectWalletAuthoriserAddress),
-		common.HexToAddress(senderAddr), common.HexToAddress(receiverAddr), big.NewInt(amount))
-	fmt.Println("authContract.Validate: ", txResult, err)
+	action, err := authContract.Actions(&c.callOpts, actionID)
 	if err != nil {
-		return false, nextTxID
+		return false, err
+	}
+	
+	return action.Triggered, nil
+}
+
+// GetTransactionStatus returns whether the transaction has been mined and,
+// if it has, whether it succeeded
+func (c EthClient) GetTransactionStatus(txHash string) (mined bool, succeeded bool, err error) {
+	receipt, err := c.client.TransactionReceipt(context.Background(), common.HexToHash(txHash))
+	if err == ethereum.NotFound {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, err
 	}
 	
-	return true, nextTxID
+	return true, receipt.Status == types.ReceiptStatusSuccessful, nil
 }
 
 func (c EthClient) GetFundingAmt(tx *EthTransaction) int64 {
@@ -259,19 +337,3 @@ func removeDidPrefix(did Did) string {
 	}
 	return didStr
 }
-
-func getNextTxID(ctx sdk.Context, keeper params.Keeper) [32]byte {
-	var nextTxID sdk.Dec
-	actionID, err := keeper.Getter().GetDec(ctx, "actionID")
-	if err == nil {
-		nextTxID = actionID.Add(sdk.NewDec(1))
-	} else {
-		nextTxID = sdk.NewDec(1)
-	}
-	keeper.Setter().SetDec(ctx, "actionID", nextTxID)
-	
-	var result [32]byte
-	copy(result[:], nextTxID.Bytes())
-	
-	return result
-}
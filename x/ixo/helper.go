@@ -2,11 +2,18 @@ package ixo
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"reflect"
 	"time"
 	
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/ed25519"
+	"github.com/tendermint/tendermint/crypto"
+	tmed25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 func SignIxoMessage(signBytes []byte, did string, privKey [64]byte) IxoSignature {
@@ -27,6 +34,117 @@ func VerifySignature(msg sdk.Msg, publicKey [32]byte, sig IxoSignature) bool {
 	return result
 }
 
+// SignIxoMessageWithPrivKey signs the msg with any tendermint private key,
+// such as a secp256k1 key of the keyring. Both ed25519 and secp256k1 keys
+// produce the 64 byte signatures carried by an IxoSignature.
+func SignIxoMessageWithPrivKey(signBytes []byte, privKey crypto.PrivKey) (IxoSignature, error) {
+	signatureBytes, err := privKey.Sign(signBytes)
+	if err != nil {
+		return IxoSignature{}, err
+	}
+	
+	return newSignatureFromBytes(signatureBytes)
+}
+
+// SignIxoMessageWithKeybase signs the msg with the named local key of the
+// keybase. Ledger keys cannot sign ixo msgs, since the Ledger app only signs
+// standard cosmos txs.
+func SignIxoMessageWithKeybase(signBytes []byte, kb keys.Keybase, name, passphrase string) (IxoSignature, error) {
+	signatureBytes, _, err := kb.Sign(name, passphrase, signBytes)
+	if err != nil {
+		return IxoSignature{}, err
+	}
+	
+	return newSignatureFromBytes(signatureBytes)
+}
+
+func newSignatureFromBytes(signatureBytes []byte) (IxoSignature, error) {
+	if len(signatureBytes) != 64 {
+		return IxoSignature{}, fmt.Errorf("expected a 64 byte signature, got %d bytes", len(signatureBytes))
+	}
+	
+	signature := [64]byte{}
+	copy(signature[:], signatureBytes)
+	return NewSignature(time.Now(), signature), nil
+}
+
+// Ed25519PubKey returns the base58 encoded ed25519 key, such as the verify key
+// of a sovrin DID, as a tendermint public key
+func Ed25519PubKey(pubKey string) crypto.PubKey {
+	publicKey := tmed25519.PubKeyEd25519{}
+	copy(publicKey[:], base58.Decode(pubKey))
+	return publicKey
+}
+
+// Secp256k1PubKey returns the base58 encoded compressed secp256k1 key as a
+// tendermint public key. It returns false if the key is not 33 bytes long.
+func Secp256k1PubKey(pubKey string) (crypto.PubKey, bool) {
+	pubKeyBytes := base58.Decode(pubKey)
+	publicKey := secp256k1.PubKeySecp256k1{}
+	if len(pubKeyBytes) != len(publicKey) {
+		return nil, false
+	}
+	
+	copy(publicKey[:], pubKeyBytes)
+	return publicKey, true
+}
+
+// VerifySignatureWithAnyKey returns true if the signature was made by any of
+// the base58 encoded ed25519 public keys
+func VerifySignatureWithAnyKey(msg sdk.Msg, pubKeys []string, sig IxoSignature) bool {
+	publicKeys := make([]crypto.PubKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		publicKeys[i] = Ed25519PubKey(pubKey)
+	}
+	
+	return VerifySignatureWithAnyPubKey(msg, publicKeys, sig)
+}
+
+// VerifySignatureWithAnyPubKey returns true if the signature was made by any
+// of the public keys, whatever their type
+func VerifySignatureWithAnyPubKey(msg sdk.Msg, pubKeys []crypto.PubKey, sig IxoSignature) bool {
+	for _, pubKey := range pubKeys {
+		if pubKey.VerifyBytes(msg.GetSignBytes(), sig.SignatureValue[:]) {
+			return true
+		}
+	}
+	
+	return false
+}
+
+// MsgTypeName identifies a msg type as its route and Go type name, such as
+// project/CreateClaimMsg, since the Type() of ixo msgs is their module name
+func MsgTypeName(msg sdk.Msg) string {
+	msgType := reflect.TypeOf(msg)
+	if msgType.Kind() == reflect.Ptr {
+		msgType = msgType.Elem()
+	}
+	
+	return msg.Route() + "/" + msgType.Name()
+}
+
+// ValidPage returns true if the page is positive and its last index fits in a
+// uint64
+func ValidPage(page, limit uint64) bool {
+	return page > 0 && limit > 0 && page <= MaxPage(limit)
+}
+
+// MaxPage returns the last page of the size whose indices fit in a uint64
+func MaxPage(limit uint64) uint64 {
+	return math.MaxUint64 / limit
+}
+
+// PageBounds returns the index of the first item of the page and the index
+// after its last item. Invalid pages are empty.
+func PageBounds(page, limit uint64) (start, end uint64) {
+	if !ValidPage(page, limit) {
+		return 0, 0
+	}
+	
+	start = (page - 1) * limit
+	return start, start + limit
+}
+
 func LookupEnv(name string, defaultValue string) string {
 	val, found := os.LookupEnv(name)
 	if found && len(val) > 0 {
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	crypto_rand "crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	
@@ -46,9 +48,18 @@ func (sd SovrinDid) String() string {
 	return fmt.Sprintf("%v", string(output))
 }
 
+// GenerateMnemonic returns a new random 12 word bip39 mnemonic, i.e. one
+// encoding 128 bits of entropy
 func GenerateMnemonic() string {
-	entropy, _ := bip39.NewEntropy(12)
-	mnemonicWords, _ := bip39.NewMnemonic(entropy)
+	entropy, err := bip39.NewEntropy(128)
+	if err != nil {
+		panic(err)
+	}
+	
+	mnemonicWords, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		panic(err)
+	}
 	return mnemonicWords
 }
 
@@ -135,3 +146,91 @@ func GetKeyPairFromSignKey(signKey string) ([32]byte, [32]byte) {
 	}
 	return *publicKey, *privateKey
 }
+
+// EncryptedMessage is a payload sealed with nacl box by a sender DID for the
+// key agreement key of a recipient DID
+type EncryptedMessage struct {
+	SenderDid    string `json:"senderDid"`
+	SenderKey    string `json:"senderKey"`
+	RecipientKey string `json:"recipientKey"`
+	Nonce        string `json:"nonce"`
+	Ciphertext   string `json:"ciphertext"`
+}
+
+func (em EncryptedMessage) String() string {
+	output, err := json.MarshalIndent(em, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	
+	return fmt.Sprintf("%v", string(output))
+}
+
+// SealMessage encrypts the message for the base58 encoded curve25519 key
+// agreement key of the recipient, published in its DID doc, and
+// authenticates it with the encryption key of the sender
+func SealMessage(message []byte, recipientKey string, sender SovrinDid) (EncryptedMessage, error) {
+	recipientPublicKey, err := getKey32(recipientKey)
+	if err != nil {
+		return EncryptedMessage{}, fmt.Errorf("invalid recipient key: %s", err)
+	}
+	senderPrivateKey, err := getKey32(sender.Secret.EncryptionPrivateKey)
+	if err != nil {
+		return EncryptedMessage{}, fmt.Errorf("invalid sender encryption private key: %s", err)
+	}
+	
+	nonce := GetNonce()
+	ciphertext := naclbox.Seal(nil, message, &nonce, &recipientPublicKey, &senderPrivateKey)
+	
+	return EncryptedMessage{
+		SenderDid:    sender.Did,
+		SenderKey:    sender.EncryptionPublicKey,
+		RecipientKey: recipientKey,
+		Nonce:        base58.Encode(nonce[:]),
+		Ciphertext:   base64.StdEncoding.EncodeToString(ciphertext),
+	}, nil
+}
+
+// OpenMessage decrypts a message sealed for the recipient. It only proves
+// that the message was sealed with SenderKey, so callers should check that
+// it is the key agreement key of SenderDid.
+func OpenMessage(encrypted EncryptedMessage, recipient SovrinDid) ([]byte, error) {
+	senderPublicKey, err := getKey32(encrypted.SenderKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender key: %s", err)
+	}
+	recipientPrivateKey, err := getKey32(recipient.Secret.EncryptionPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient encryption private key: %s", err)
+	}
+	
+	nonceBytes := getArrayFromKey(encrypted.Nonce)
+	if len(nonceBytes) != 24 {
+		return nil, errors.New("nonce must be 24 base58 encoded bytes")
+	}
+	var nonce [24]byte
+	copy(nonce[:], nonceBytes)
+	
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ciphertext: %s", err)
+	}
+	
+	message, ok := naclbox.Open(nil, ciphertext, &nonce, &senderPublicKey, &recipientPrivateKey)
+	if !ok {
+		return nil, errors.New("message could not be decrypted with the recipient key")
+	}
+	
+	return message, nil
+}
+
+func getKey32(key string) ([32]byte, error) {
+	var key32 [32]byte
+	keyBytes := getArrayFromKey(key)
+	if len(keyBytes) != 32 {
+		return key32, errors.New("key must be 32 base58 encoded bytes")
+	}
+	copy(key32[:], keyBytes)
+	
+	return key32, nil
+}
@@ -0,0 +1,81 @@
+package sovrin
+
+import (
+	"encoding/base64"
+	"testing"
+	
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealAndOpenMessage(t *testing.T) {
+	sender := Gen()
+	recipient := Gen()
+	
+	encrypted, err := SealMessage([]byte("hello"), recipient.EncryptionPublicKey, sender)
+	require.Nil(t, err)
+	require.Equal(t, sender.Did, encrypted.SenderDid)
+	require.Equal(t, sender.EncryptionPublicKey, encrypted.SenderKey)
+	require.Equal(t, recipient.EncryptionPublicKey, encrypted.RecipientKey)
+	
+	message, err := OpenMessage(encrypted, recipient)
+	require.Nil(t, err)
+	require.Equal(t, []byte("hello"), message)
+	
+	// Sealing the same message twice uses a new nonce
+	other, err := SealMessage([]byte("hello"), recipient.EncryptionPublicKey, sender)
+	require.Nil(t, err)
+	require.NotEqual(t, encrypted.Nonce, other.Nonce)
+	require.NotEqual(t, encrypted.Ciphertext, other.Ciphertext)
+}
+
+func TestOpenMessageWithWrongRecipient(t *testing.T) {
+	sender := Gen()
+	recipient := Gen()
+	
+	encrypted, err := SealMessage([]byte("hello"), recipient.EncryptionPublicKey, sender)
+	require.Nil(t, err)
+	
+	_, err = OpenMessage(encrypted, Gen())
+	require.NotNil(t, err)
+	
+	// Nor can the sender open it
+	_, err = OpenMessage(encrypted, sender)
+	require.NotNil(t, err)
+}
+
+func TestOpenTamperedMessage(t *testing.T) {
+	sender := Gen()
+	recipient := Gen()
+	
+	encrypted, err := SealMessage([]byte("hello"), recipient.EncryptionPublicKey, sender)
+	require.Nil(t, err)
+	
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted.Ciphertext)
+	require.Nil(t, err)
+	ciphertext[len(ciphertext)-1] ^= 0x01
+	tampered := encrypted
+	tampered.Ciphertext = base64.StdEncoding.EncodeToString(ciphertext)
+	_, err = OpenMessage(tampered, recipient)
+	require.NotNil(t, err)
+	
+	// A message claiming another sender key does not open either
+	spoofed := encrypted
+	spoofed.SenderKey = Gen().EncryptionPublicKey
+	_, err = OpenMessage(spoofed, recipient)
+	require.NotNil(t, err)
+	
+	malformed := encrypted
+	malformed.Nonce = "abc"
+	_, err = OpenMessage(malformed, recipient)
+	require.NotNil(t, err)
+}
+
+func TestSealMessageWithInvalidKeys(t *testing.T) {
+	sender := Gen()
+	
+	_, err := SealMessage([]byte("hello"), "abc", sender)
+	require.NotNil(t, err)
+	
+	_, err = SealMessage([]byte("hello"), Gen().EncryptionPublicKey, SovrinDid{Did: sender.Did})
+	require.NotNil(t, err)
+}
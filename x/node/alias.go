@@ -12,13 +12,37 @@ const (
 	StoreKey     = types.StoreKey
 	
 	DefaultCodeSpace = types.DefaultCodeSpace
+	
+	NodeActive       = types.NodeActive
+	NodeDeregistered = types.NodeDeregistered
+	
+	QueryNode  = keeper.QueryNode
+	QueryNodes = keeper.QueryNodes
 )
 
 type (
 	Keeper = keeper.Keeper
+	
+	Node       = types.Node
+	NodeStatus = types.NodeStatus
+	
+	MsgRegisterNode   = types.MsgRegisterNode
+	MsgUpdateNode     = types.MsgUpdateNode
+	MsgDeregisterNode = types.MsgDeregisterNode
 )
 
 var (
-	NewKeeper = keeper.NewKeeper
-	ModuleCdc = types.ModuleCdc
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+	
+	NewNode                 = types.NewNode
+	NewMsgRegisterNode      = types.NewMsgRegisterNode
+	NewMsgUpdateNode        = types.NewMsgUpdateNode
+	NewMsgDeregisterNode    = types.NewMsgDeregisterNode
+	ValidateServiceEndpoint = types.ValidateServiceEndpoint
+	
+	ErrNodeNotFound = types.ErrNodeNotFound
+	
+	RegisterCodec = types.RegisterCodec
+	ModuleCdc     = types.ModuleCdc
 )
@@ -0,0 +1,46 @@
+package node
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+// NewAnteHandler checks that node messages are signed by the node DID. As the
+// operator must be the DID's own account, this stops anyone other than the
+// holder of a DID from registering it as a node.
+func NewAnteHandler(didKeeper did.Keeper) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (_ sdk.Context, _ sdk.Result, abort bool) {
+		
+		ixoTx, ok := tx.(ixo.IxoTx)
+		if !ok {
+			return ctx, sdk.ErrInternal("tx must be ixo.IxoTx").Result(), true
+		}
+		
+		msg := ixoTx.GetMsgs()[0]
+		nodeDid := ixo.Did(msg.GetSigners()[0])
+		pubKeys, err := didKeeper.GetSigningKeys(ctx, nodeDid, msg)
+		if err != nil {
+			return ctx,
+				sdk.ErrUnauthorized("Node did not found").Result(),
+				true
+		}
+		
+		var sigs = ixoTx.GetSignatures()
+		if len(sigs) != 1 {
+			return ctx,
+				sdk.ErrUnauthorized("there can only be one signer").Result(),
+				true
+		}
+		
+		res := ixo.VerifySignatureWithAnyPubKey(msg, pubKeys, sigs[0])
+		
+		if !res {
+			return ctx, sdk.ErrInternal("Signature Verification failed").Result(), true
+		}
+		
+		return ctx, sdk.Result{}, false // continue...
+		
+	}
+}
@@ -0,0 +1,77 @@
+package node
+
+import (
+	"encoding/json"
+	"testing"
+	
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
+)
+
+// signTx signs the msg as the CLI does and decodes the tx as the app does, so
+// that the msg's sign bytes are set
+func signTx(t *testing.T, cdc *codec.Codec, msg sdk.Msg, signer sovrin.SovrinDid) sdk.Tx {
+	privKey := [64]byte{}
+	copy(privKey[:], base58.Decode(signer.Secret.SignKey))
+	copy(privKey[32:], base58.Decode(signer.VerifyKey))
+	
+	msgBytes, err := json.Marshal(msg)
+	require.Nil(t, err)
+	
+	signature := ixo.SignIxoMessage(msgBytes, signer.Did, privKey)
+	bz, err := cdc.MarshalJSON(ixo.NewIxoTxSingleMsg(msg, signature))
+	require.Nil(t, err)
+	
+	tx, sdkErr := ixo.DefaultTxDecoder(cdc)(bz)
+	require.Nil(t, sdkErr)
+	return tx
+}
+
+func TestAnteHandlerRequiresNodeDidSignature(t *testing.T) {
+	ctx, _, _, _, dk := keeper.CreateTestInput()
+	anteHandler := NewAnteHandler(dk)
+	
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	
+	nodeSovrinDid := sovrin.FromMnemonic(sovrin.GenerateMnemonic())
+	otherSovrinDid := sovrin.FromMnemonic(sovrin.GenerateMnemonic())
+	msg := types.NewMsgRegisterNode(nodeSovrinDid.Did, endpoint, sdk.NewCoins())
+	
+	// The node DID must exist
+	_, res, abort := anteHandler(ctx, signTx(t, cdc, msg, nodeSovrinDid), false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	
+	for _, sovrinDid := range []sovrin.SovrinDid{nodeSovrinDid, otherSovrinDid} {
+		didDoc := did.InitDidDoc(sovrinDid.Did, sovrinDid.VerifyKey)
+		require.Nil(t, dk.SetDidDoc(ctx, &didDoc))
+	}
+	
+	// Another DID cannot register the node DID
+	_, res, abort = anteHandler(ctx, signTx(t, cdc, msg, otherSovrinDid), false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeInternal, res.Code)
+	
+	// Unsigned messages are rejected
+	_, res, abort = anteHandler(ctx, ixo.NewIxoTx([]sdk.Msg{msg}, nil), false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	
+	_, res, abort = anteHandler(ctx, signTx(t, cdc, msg, nodeSovrinDid), false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	
+	_, res, abort = anteHandler(ctx, signTx(t, cdc, types.NewMsgDeregisterNode(nodeSovrinDid.Did), nodeSovrinDid), false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
+)
+
+func GetNodeCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getNode [node-did]",
+		Short: "Get a registered cell node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryNode, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			
+			var node types.Node
+			err = cdc.UnmarshalJSON(res, &node)
+			if err != nil {
+				return err
+			}
+			
+			output, err := cdc.MarshalJSONIndent(node, "", "  ")
+			if err != nil {
+				return err
+			}
+			
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetNodesCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getNodes",
+		Short: "Get all registered cell nodes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+				keeper.QueryNodes), nil)
+			if err != nil {
+				return err
+			}
+			
+			var nodes []types.Node
+			err = cdc.UnmarshalJSON(res, &nodes)
+			if err != nil {
+				return err
+			}
+			
+			output, err := cdc.MarshalJSONIndent(nodes, "", "  ")
+			if err != nil {
+				return err
+			}
+			
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
+)
+
+const (
+	FlagStake = "stake"
+)
+
+func IxoSignAndBroadcast(cdc *codec.Codec, ctx context.CLIContext, msg sdk.Msg, sovrinDid sovrin.SovrinDid) error {
+	signature, err := didcli.SignIxoMsg(msg, sovrinDid)
+	if err != nil {
+		return err
+	}
+	
+	tx := ixo.NewIxoTxSingleMsg(msg, signature)
+	
+	bz, err := cdc.MarshalJSON(tx)
+	if err != nil {
+		panic(err)
+	}
+	
+	res, err := ctx.BroadcastTx(bz)
+	if err != nil {
+		return err
+	}
+	
+	fmt.Println(res.String())
+	fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.TxHash)
+	return nil
+}
+
+func RegisterNodeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registerNode [service-endpoint]",
+		Short: "Register the sovrin DID as a cell node. The stake is locked from the DID's account.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			stake, err := sdk.ParseCoins(viper.GetString(FlagStake))
+			if err != nil {
+				return err
+			}
+			
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgRegisterNode(sovrinDid.Did, args[0], stake)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return IxoSignAndBroadcast(cdc, cliCtx, msg, sovrinDid)
+		},
+	}
+	
+	cmd.Flags().String(FlagStake, "", "Stake to lock while the node is registered (e.g. 1000ixo)")
+	
+	return cmd
+}
+
+func UpdateNodeCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "updateNode [service-endpoint]",
+		Short: "Update the service endpoint of a cell node, signed by the sovrin DID of the node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgUpdateNode(sovrinDid.Did, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return IxoSignAndBroadcast(cdc, cliCtx, msg, sovrinDid)
+		},
+	}
+}
+
+func DeregisterNodeCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deregisterNode",
+		Short: "Deregister a cell node and return its stake, signed by the sovrin DID of the node",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+			
+			msg := types.NewMsgDeregisterNode(sovrinDid.Did)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			
+			return IxoSignAndBroadcast(cdc, cliCtx, msg, sovrinDid)
+		},
+	}
+}
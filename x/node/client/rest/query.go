@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/node/{did}", queryNodeRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/nodes", queryNodesRequestHandler(cliCtx)).Methods("GET")
+}
+
+func queryNodeRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+			keeper.QueryNode, vars["did"]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		
+		var node types.Node
+		err = cliCtx.Codec.UnmarshalJSON(res, &node)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, cliCtx, node)
+	}
+}
+
+func queryNodesRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+			keeper.QueryNodes), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		nodes := []types.Node{}
+		err = cliCtx.Codec.UnmarshalJSON(res, &nodes)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		
+		rest.PostProcessResponse(w, cliCtx, nodes)
+	}
+}
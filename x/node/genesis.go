@@ -1,6 +1,8 @@
 package node
 
 import (
+	"fmt"
+	
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -13,20 +15,49 @@ import (
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) []abci.ValidatorUpdate {
 	
 	for _, w := range data.ETHGenesisStates {
-		keeper.SetNode(ctx, KeyNodeID, w.Did)
-		keeper.SetNode(ctx, KeyEthWallet, w.EthWallet)
+		keeper.SetNodeParam(ctx, KeyNodeID, w.Did)
+		keeper.SetNodeParam(ctx, KeyEthWallet, w.EthWallet)
+	}
+	
+	for _, n := range data.Nodes {
+		keeper.SetNode(ctx, n)
 	}
 	
 	return []abci.ValidatorUpdate{}
 }
 
 func WriteGenesis(ctx sdk.Context, keeper keeper.Keeper) GenesisState {
-	did, _ := keeper.GetNode(ctx, KeyNodeID)
-	wallet, _ := keeper.GetNode(ctx, KeyEthWallet)
+	did, _ := keeper.GetNodeParam(ctx, KeyNodeID)
+	wallet, _ := keeper.GetNodeParam(ctx, KeyEthWallet)
 	
 	return GenesisState{
 		ETHGenesisStates: []ETHGenesisState{{Did: did, EthWallet: wallet}},
+		Nodes:            keeper.GetAllNodes(ctx),
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	dids := make(map[string]bool)
+	for _, n := range data.Nodes {
+		if dids[n.Did] {
+			return fmt.Errorf("duplicate node %s", n.Did)
+		}
+		dids[n.Did] = true
+		
+		if len(n.Did) == 0 {
+			return fmt.Errorf("node did is empty")
+		} else if err := ValidateServiceEndpoint(n.ServiceEndpoint); err != nil {
+			return fmt.Errorf("node %s: %s", n.Did, err.Error())
+		} else if n.Operator.Empty() {
+			return fmt.Errorf("node %s has no operator", n.Did)
+		} else if !n.Stake.IsValid() {
+			return fmt.Errorf("node %s has invalid stake %s", n.Did, n.Stake)
+		} else if n.Status != NodeActive && n.Status != NodeDeregistered {
+			return fmt.Errorf("node %s has invalid status %s", n.Did, n.Status)
+		}
 	}
+	
+	return nil
 }
 
 func DefaultGenesis() *GenesisState {
@@ -46,5 +77,8 @@ func DefaultGenesis() *GenesisState {
 	sovrinDid := sovrin.FromMnemonic(secret)
 	did := "did:ixo:" + sovrinDid.Did
 	
-	return &GenesisState{[]ETHGenesisState{{Did: did, EthWallet: ethWallet}}}
+	return &GenesisState{
+		ETHGenesisStates: []ETHGenesisState{{Did: did, EthWallet: ethWallet}},
+		Nodes:            []Node{},
+	}
 }
@@ -0,0 +1,121 @@
+package node
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
+)
+
+func NewHandler(k keeper.Keeper, dk did.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgRegisterNode:
+			return handleMsgRegisterNode(ctx, k, dk, msg)
+		case types.MsgUpdateNode:
+			return handleMsgUpdateNode(ctx, k, msg)
+		case types.MsgDeregisterNode:
+			return handleMsgDeregisterNode(ctx, k, msg)
+		default:
+			return sdk.ErrUnknownRequest("No match for message type.").Result()
+		}
+	}
+}
+
+func handleMsgRegisterNode(ctx sdk.Context, k keeper.Keeper, dk did.Keeper, msg types.MsgRegisterNode) sdk.Result {
+	if _, err := dk.GetDidDoc(ctx, msg.NodeDid); err != nil {
+		return types.ErrNodeDidDoesNotExist(msg.NodeDid).Result()
+	}
+	
+	// A deregistered node can only be registered again by its operator
+	if existing, found := k.GetNode(ctx, msg.NodeDid); found {
+		if existing.IsActive() {
+			return types.ErrNodeAlreadyExists(msg.NodeDid).Result()
+		} else if !existing.Operator.Equals(msg.Operator) {
+			return types.ErrInvalidNodeOperator(msg.NodeDid).Result()
+		}
+	}
+	
+	if err := k.LockStake(ctx, msg.Operator, msg.Stake); err != nil {
+		return err.Result()
+	}
+	
+	k.SetNode(ctx, types.NewNode(msg.NodeDid, msg.ServiceEndpoint, msg.Operator, msg.Stake, ctx.BlockHeight()))
+	
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterNode,
+			sdk.NewAttribute(types.AttributeKeyNodeDid, msg.NodeDid),
+			sdk.NewAttribute(types.AttributeKeyServiceEndpoint, msg.ServiceEndpoint),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator.String()),
+			sdk.NewAttribute(types.AttributeKeyStake, msg.Stake.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator.String()),
+		),
+	})
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgUpdateNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateNode) sdk.Result {
+	node, found := k.GetNode(ctx, msg.NodeDid)
+	if !found || !node.IsActive() {
+		return types.ErrNodeNotFound(msg.NodeDid).Result()
+	} else if !node.Operator.Equals(msg.Operator) {
+		return types.ErrInvalidNodeOperator(msg.NodeDid).Result()
+	}
+	
+	node.ServiceEndpoint = msg.ServiceEndpoint
+	k.SetNode(ctx, node)
+	
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateNode,
+			sdk.NewAttribute(types.AttributeKeyNodeDid, msg.NodeDid),
+			sdk.NewAttribute(types.AttributeKeyServiceEndpoint, msg.ServiceEndpoint),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator.String()),
+		),
+	})
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgDeregisterNode(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeregisterNode) sdk.Result {
+	node, found := k.GetNode(ctx, msg.NodeDid)
+	if !found || !node.IsActive() {
+		return types.ErrNodeNotFound(msg.NodeDid).Result()
+	} else if !node.Operator.Equals(msg.Operator) {
+		return types.ErrInvalidNodeOperator(msg.NodeDid).Result()
+	}
+	
+	if err := k.UnlockStake(ctx, node.Operator, node.Stake); err != nil {
+		return err.Result()
+	}
+	
+	node.Stake = sdk.NewCoins()
+	node.Status = types.NodeDeregistered
+	k.SetNode(ctx, node)
+	
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeregisterNode,
+			sdk.NewAttribute(types.AttributeKeyNodeDid, msg.NodeDid),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator.String()),
+		),
+	})
+	
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
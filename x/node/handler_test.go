@@ -0,0 +1,110 @@
+package node
+
+import (
+	"testing"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
+)
+
+var (
+	nodeDid   = "did:sov:4XJLBfGtWSGKSz4BeRxdun"
+	endpoint  = "https://cellnode.ixo.world"
+	operator  = ixo.DidToAddr(nodeDid)
+	operator2 = sdk.AccAddress([]byte("operator2"))
+)
+
+func TestHandlerRegisterNode(t *testing.T) {
+	ctx, k, _, bk, dk := keeper.CreateTestInput()
+	handler := NewHandler(k, dk)
+	
+	stake := sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 100))
+	_, err := bk.AddCoins(ctx, operator, stake)
+	require.Nil(t, err)
+	
+	// Node DID must exist
+	res := handler(ctx, types.NewMsgRegisterNode(nodeDid, endpoint, stake))
+	require.Equal(t, types.CodeNodeDidDoesNotExist, res.Code)
+	
+	didDoc := did.InitDidDoc(nodeDid, "pubKey")
+	require.Nil(t, dk.SetDidDoc(ctx, &didDoc))
+	
+	res = handler(ctx, types.NewMsgRegisterNode(nodeDid, endpoint, stake))
+	require.True(t, res.IsOK())
+	require.True(t, k.IsActiveNode(ctx, nodeDid))
+	require.True(t, bk.GetCoins(ctx, operator).IsZero())
+	
+	// Cannot register an active node twice
+	res = handler(ctx, types.NewMsgRegisterNode(nodeDid, endpoint, sdk.NewCoins()))
+	require.Equal(t, types.CodeNodeAlreadyExists, res.Code)
+}
+
+func TestHandlerUpdateAndDeregisterNode(t *testing.T) {
+	ctx, k, _, bk, dk := keeper.CreateTestInput()
+	handler := NewHandler(k, dk)
+	
+	stake := sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 100))
+	_, err := bk.AddCoins(ctx, operator, stake)
+	require.Nil(t, err)
+	
+	didDoc := did.InitDidDoc(nodeDid, "pubKey")
+	require.Nil(t, dk.SetDidDoc(ctx, &didDoc))
+	res := handler(ctx, types.NewMsgRegisterNode(nodeDid, endpoint, stake))
+	require.True(t, res.IsOK())
+	
+	// Only the operator can update or deregister the node
+	newEndpoint := "https://cellnode2.ixo.world"
+	updateMsg := types.NewMsgUpdateNode(nodeDid, newEndpoint)
+	updateMsg.Operator = operator2
+	res = handler(ctx, updateMsg)
+	require.Equal(t, types.CodeInvalidNodeOperator, res.Code)
+	deregisterMsg := types.NewMsgDeregisterNode(nodeDid)
+	deregisterMsg.Operator = operator2
+	res = handler(ctx, deregisterMsg)
+	require.Equal(t, types.CodeInvalidNodeOperator, res.Code)
+	
+	res = handler(ctx, types.NewMsgUpdateNode(nodeDid, newEndpoint))
+	require.True(t, res.IsOK())
+	node, found := k.GetNode(ctx, nodeDid)
+	require.True(t, found)
+	require.Equal(t, newEndpoint, node.ServiceEndpoint)
+	
+	// Deregistering returns the stake
+	res = handler(ctx, types.NewMsgDeregisterNode(nodeDid))
+	require.True(t, res.IsOK())
+	require.False(t, k.IsActiveNode(ctx, nodeDid))
+	require.Equal(t, stake, bk.GetCoins(ctx, operator))
+	
+	res = handler(ctx, types.NewMsgUpdateNode(nodeDid, newEndpoint))
+	require.Equal(t, types.CodeNodeNotFound, res.Code)
+	
+	// Only the previous operator can register it again
+	registerMsg := types.NewMsgRegisterNode(nodeDid, endpoint, sdk.NewCoins())
+	registerMsg.Operator = operator2
+	res = handler(ctx, registerMsg)
+	require.Equal(t, types.CodeInvalidNodeOperator, res.Code)
+	res = handler(ctx, types.NewMsgRegisterNode(nodeDid, endpoint, sdk.NewCoins()))
+	require.True(t, res.IsOK())
+}
+
+func TestMsgsRequireNodeDidOperator(t *testing.T) {
+	msg := types.NewMsgRegisterNode(nodeDid, endpoint, sdk.NewCoins())
+	require.Nil(t, msg.ValidateBasic())
+	
+	// The operator must be the node DID's own account
+	msg.Operator = operator2
+	require.Equal(t, types.CodeInvalidNodeOperator, msg.ValidateBasic().Code())
+	
+	updateMsg := types.NewMsgUpdateNode(nodeDid, endpoint)
+	updateMsg.Operator = operator2
+	require.Equal(t, types.CodeInvalidNodeOperator, updateMsg.ValidateBasic().Code())
+	
+	deregisterMsg := types.NewMsgDeregisterNode(nodeDid)
+	deregisterMsg.Operator = operator2
+	require.Equal(t, types.CodeInvalidNodeOperator, deregisterMsg.ValidateBasic().Code())
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	
 	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
 	"github.com/ixofoundation/ixo-cosmos/x/params"
@@ -10,27 +11,28 @@ import (
 
 type Keeper struct {
 	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
 	paramsKeeper params.Keeper
+	supplyKeeper supply.Keeper
 }
 
-func NewKeeper(cdc *codec.Codec, paramsKeeper params.Keeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper,
+	supplyKeeper supply.Keeper) Keeper {
 	return Keeper{
 		cdc:          cdc,
+		storeKey:     key,
 		paramsKeeper: paramsKeeper,
+		supplyKeeper: supplyKeeper,
 	}
 }
 
-func InitKeeper(cdc *codec.Codec, paramsKeeper params.Keeper) Keeper {
-	k := NewKeeper(cdc, paramsKeeper)
-	
-	return k
-}
-
-func (k Keeper) SetNode(ctx sdk.Context, key string, value string) {
+// SetNodeParam stores the genesis node settings (e.g. the node's Ethereum
+// wallet) in the params store
+func (k Keeper) SetNodeParam(ctx sdk.Context, key string, value string) {
 	k.paramsKeeper.Setter().SetString(ctx, MakeNodeKey(key), value)
 }
 
-func (k Keeper) GetNode(ctx sdk.Context, key string) (string, sdk.Error) {
+func (k Keeper) GetNodeParam(ctx sdk.Context, key string) (string, sdk.Error) {
 	r, err := k.paramsKeeper.Getter().GetString(ctx, MakeNodeKey(key))
 	if err != nil {
 		return "", types.ErrorInvalidQueryNode()
@@ -42,3 +44,58 @@ func (k Keeper) GetNode(ctx sdk.Context, key string) (string, sdk.Error) {
 func MakeNodeKey(key string) string {
 	return "node/" + key
 }
+
+func (k Keeper) GetNode(ctx sdk.Context, did string) (types.Node, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNodeKey(did))
+	if bz == nil {
+		return types.Node{}, false
+	}
+	
+	var node types.Node
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &node)
+	
+	return node, true
+}
+
+func (k Keeper) SetNode(ctx sdk.Context, node types.Node) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNodeKey(node.Did), k.cdc.MustMarshalBinaryLengthPrefixed(node))
+}
+
+func (k Keeper) GetAllNodes(ctx sdk.Context) (nodes []types.Node) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.NodeKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var node types.Node
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &node)
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// IsActiveNode returns true if the DID belongs to a registered node that has
+// not been deregistered
+func (k Keeper) IsActiveNode(ctx sdk.Context, did string) bool {
+	node, found := k.GetNode(ctx, did)
+	return found && node.IsActive()
+}
+
+// LockStake moves the stake from the operator's account to the node module
+// account, where it stays until the node deregisters
+func (k Keeper) LockStake(ctx sdk.Context, operator sdk.AccAddress, stake sdk.Coins) sdk.Error {
+	if stake.IsZero() {
+		return nil
+	}
+	
+	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, operator, types.ModuleName, stake)
+}
+
+func (k Keeper) UnlockStake(ctx sdk.Context, operator sdk.AccAddress, stake sdk.Coins) sdk.Error {
+	if stake.IsZero() {
+		return nil
+	}
+	
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, operator, stake)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+	
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
+)
+
+const (
+	QueryNode  = "queryNode"
+	QueryNodes = "queryNodes"
+)
+
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abciTypes.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryNode:
+			return queryNode(ctx, path[1:], k)
+		case QueryNodes:
+			return queryNodes(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("Unknown node query endpoint")
+		}
+	}
+}
+
+func queryNode(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("Node did is missing")
+	}
+	
+	node, found := k.GetNode(ctx, path[0])
+	if !found {
+		return nil, types.ErrNodeNotFound(path[0])
+	}
+	
+	res, errRes := codec.MarshalJSONIndent(k.cdc, node)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+	
+	return res, nil
+}
+
+func queryNodes(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	nodes := k.GetAllNodes(ctx)
+	if nodes == nil {
+		nodes = []types.Node{}
+	}
+	
+	res, errRes := codec.MarshalJSONIndent(k.cdc, nodes)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+	
+	return res, nil
+}
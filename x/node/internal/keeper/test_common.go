@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	cParams "github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/did"
+	"github.com/ixofoundation/ixo-cosmos/x/node/internal/types"
+	"github.com/ixofoundation/ixo-cosmos/x/params"
+)
+
+func CreateTestInput() (sdk.Context, Keeper, *codec.Codec, bank.Keeper, did.Keeper) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	actStoreKey := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParam := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey("transient_params")
+	supplyStoreKey := sdk.NewKVStoreKey(supply.StoreKey)
+	didStoreKey := sdk.NewKVStoreKey(did.StoreKey)
+	
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(actStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyParam, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(supplyStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(didStoreKey, sdk.StoreTypeIAVL, nil)
+	_ = ms.LoadLatestVersion()
+	
+	ctx := sdk.NewContext(ms, abciTypes.Header{}, true, log.NewNopLogger())
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	did.RegisterCodec(cdc)
+	
+	paramsKeeper := params.NewKeeper(cdc, keyParam)
+	
+	pk1 := cParams.NewKeeper(cdc, keyParam, tkeyParams, cParams.DefaultCodespace)
+	accountKeeper := auth.NewAccountKeeper(
+		cdc, actStoreKey, pk1.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount,
+	)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
+	maccPerms := map[string][]string{
+		types.ModuleName: nil,
+	}
+	supplyKeeper := supply.NewKeeper(cdc, supplyStoreKey, accountKeeper, bankKeeper, maccPerms)
+	didKeeper := did.NewKeeper(cdc, didStoreKey)
+	
+	keeper := NewKeeper(cdc, storeKey, paramsKeeper, supplyKeeper)
+	
+	return ctx, keeper, cdc, bankKeeper, didKeeper
+}
@@ -8,5 +8,12 @@ var ModuleCdc *codec.Codec
 
 func init() {
 	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
 	ModuleCdc.Seal()
 }
+
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgRegisterNode{}, "node/MsgRegisterNode", nil)
+	cdc.RegisterConcrete(MsgUpdateNode{}, "node/MsgUpdateNode", nil)
+	cdc.RegisterConcrete(MsgDeregisterNode{}, "node/MsgDeregisterNode", nil)
+}
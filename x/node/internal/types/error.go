@@ -5,11 +5,40 @@ import (
 )
 
 const (
-	DefaultCodeSpace     sdk.CodespaceType = ModuleName
-	CodeInvalidQueryNode                   = 501
+	DefaultCodeSpace        sdk.CodespaceType = ModuleName
+	CodeInvalidQueryNode                      = 501
+	CodeInvalidNode         sdk.CodeType      = 502
+	CodeNodeNotFound        sdk.CodeType      = 503
+	CodeNodeAlreadyExists   sdk.CodeType      = 504
+	CodeInvalidNodeOperator sdk.CodeType      = 505
+	CodeNodeDidDoesNotExist sdk.CodeType      = 506
 )
 
 func ErrorInvalidQueryNode() sdk.Error {
 	return sdk.NewError(DefaultCodeSpace, CodeInvalidQueryNode,
 		"Error occurred while querying node data")
 }
+
+func ErrInvalidNode(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeInvalidNode, msg)
+}
+
+func ErrNodeNotFound(did string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeNodeNotFound,
+		"Node "+did+" is not registered")
+}
+
+func ErrNodeAlreadyExists(did string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeNodeAlreadyExists,
+		"Node "+did+" is already registered")
+}
+
+func ErrInvalidNodeOperator(did string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeInvalidNodeOperator,
+		"Signer is not the operator of node "+did)
+}
+
+func ErrNodeDidDoesNotExist(did string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeNodeDidDoesNotExist,
+		"Node did "+did+" does not exist")
+}
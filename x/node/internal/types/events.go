@@ -0,0 +1,14 @@
+package types
+
+const (
+	EventTypeRegisterNode   = "register_node"
+	EventTypeUpdateNode     = "update_node"
+	EventTypeDeregisterNode = "deregister_node"
+	
+	AttributeKeyNodeDid         = "node_did"
+	AttributeKeyServiceEndpoint = "service_endpoint"
+	AttributeKeyOperator        = "operator"
+	AttributeKeyStake           = "stake"
+	
+	AttributeValueCategory = ModuleName
+)
@@ -6,3 +6,11 @@ const (
 	RouterKey    = StoreKey
 	QuerierRoute = RouterKey
 )
+
+var (
+	NodeKey = []byte{0x01}
+)
+
+func GetNodeKey(did string) []byte {
+	return append(NodeKey, []byte(did)...)
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"net/url"
+	"strings"
+	
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+var (
+	_ sdk.Msg = MsgRegisterNode{}
+	_ sdk.Msg = MsgUpdateNode{}
+	_ sdk.Msg = MsgDeregisterNode{}
+)
+
+// Node messages are ixo messages signed by the node DID, which proves that
+// the DID is controlled by the operator. The operator is the DID's own
+// account, which holds the node's stake.
+type MsgRegisterNode struct { // signBytes should not be changed to sign_bytes because of ixo.types.DefaultTxDecoder
+	SignBytes       string         `json:"signBytes" yaml:"signBytes"`
+	NodeDid         string         `json:"nodeDid" yaml:"nodeDid"`
+	ServiceEndpoint string         `json:"serviceEndpoint" yaml:"serviceEndpoint"`
+	Operator        sdk.AccAddress `json:"operator" yaml:"operator"`
+	Stake           sdk.Coins      `json:"stake" yaml:"stake"`
+}
+
+func NewMsgRegisterNode(nodeDid, serviceEndpoint string, stake sdk.Coins) MsgRegisterNode {
+	return MsgRegisterNode{
+		NodeDid:         nodeDid,
+		ServiceEndpoint: serviceEndpoint,
+		Operator:        ixo.DidToAddr(nodeDid),
+		Stake:           stake,
+	}
+}
+
+func (msg MsgRegisterNode) Type() string  { return ModuleName }
+func (msg MsgRegisterNode) Route() string { return RouterKey }
+func (msg MsgRegisterNode) ValidateBasic() sdk.Error {
+	if err := validateNodeDid(msg.NodeDid); err != nil {
+		return err
+	} else if err := ValidateServiceEndpoint(msg.ServiceEndpoint); err != nil {
+		return err
+	} else if err := validateOperator(msg.NodeDid, msg.Operator); err != nil {
+		return err
+	} else if !msg.Stake.IsValid() {
+		return sdk.ErrInvalidCoins("Stake is invalid: " + msg.Stake.String())
+	}
+	
+	return nil
+}
+
+func (msg MsgRegisterNode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.NodeDid)}
+}
+
+func (msg MsgRegisterNode) GetSignBytes() []byte {
+	return []byte(msg.SignBytes)
+}
+
+type MsgUpdateNode struct {
+	SignBytes       string         `json:"signBytes" yaml:"signBytes"`
+	NodeDid         string         `json:"nodeDid" yaml:"nodeDid"`
+	ServiceEndpoint string         `json:"serviceEndpoint" yaml:"serviceEndpoint"`
+	Operator        sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+func NewMsgUpdateNode(nodeDid, serviceEndpoint string) MsgUpdateNode {
+	return MsgUpdateNode{
+		NodeDid:         nodeDid,
+		ServiceEndpoint: serviceEndpoint,
+		Operator:        ixo.DidToAddr(nodeDid),
+	}
+}
+
+func (msg MsgUpdateNode) Type() string  { return ModuleName }
+func (msg MsgUpdateNode) Route() string { return RouterKey }
+func (msg MsgUpdateNode) ValidateBasic() sdk.Error {
+	if err := validateNodeDid(msg.NodeDid); err != nil {
+		return err
+	} else if err := ValidateServiceEndpoint(msg.ServiceEndpoint); err != nil {
+		return err
+	} else if err := validateOperator(msg.NodeDid, msg.Operator); err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (msg MsgUpdateNode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.NodeDid)}
+}
+
+func (msg MsgUpdateNode) GetSignBytes() []byte {
+	return []byte(msg.SignBytes)
+}
+
+type MsgDeregisterNode struct {
+	SignBytes string         `json:"signBytes" yaml:"signBytes"`
+	NodeDid   string         `json:"nodeDid" yaml:"nodeDid"`
+	Operator  sdk.AccAddress `json:"operator" yaml:"operator"`
+}
+
+func NewMsgDeregisterNode(nodeDid string) MsgDeregisterNode {
+	return MsgDeregisterNode{
+		NodeDid:  nodeDid,
+		Operator: ixo.DidToAddr(nodeDid),
+	}
+}
+
+func (msg MsgDeregisterNode) Type() string  { return ModuleName }
+func (msg MsgDeregisterNode) Route() string { return RouterKey }
+func (msg MsgDeregisterNode) ValidateBasic() sdk.Error {
+	if err := validateNodeDid(msg.NodeDid); err != nil {
+		return err
+	} else if err := validateOperator(msg.NodeDid, msg.Operator); err != nil {
+		return err
+	}
+	
+	return nil
+}
+
+func (msg MsgDeregisterNode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.NodeDid)}
+}
+
+func (msg MsgDeregisterNode) GetSignBytes() []byte {
+	return []byte(msg.SignBytes)
+}
+
+func validateNodeDid(did string) sdk.Error {
+	if len(strings.TrimSpace(did)) == 0 {
+		return ErrInvalidNode("Node did is empty")
+	}
+	
+	return nil
+}
+
+// validateOperator checks that the operator is the node DID's own account, so
+// that only the holder of the DID can operate it
+func validateOperator(did string, operator sdk.AccAddress) sdk.Error {
+	if operator.Empty() {
+		return sdk.ErrInvalidAddress("Operator is empty")
+	} else if !operator.Equals(ixo.DidToAddr(did)) {
+		return ErrInvalidNodeOperator(did)
+	}
+	
+	return nil
+}
+
+// ValidateServiceEndpoint checks that the endpoint is an absolute http(s) URL
+func ValidateServiceEndpoint(endpoint string) sdk.Error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ErrInvalidNode("Invalid service endpoint: " + err.Error())
+	} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidNode("Service endpoint must be an http(s) URL: " + endpoint)
+	}
+	
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type NodeStatus string
+
+const (
+	NodeActive       NodeStatus = "active"
+	NodeDeregistered NodeStatus = "deregistered"
+)
+
+// Node is a cell node registered on chain. Projects name the cell node that
+// hosts them by its DID, so a project can only be created on an active node.
+// The optional stake is held by the node module until the node deregisters.
+type Node struct {
+	Did             string         `json:"did" yaml:"did"`
+	ServiceEndpoint string         `json:"serviceEndpoint" yaml:"serviceEndpoint"`
+	Operator        sdk.AccAddress `json:"operator" yaml:"operator"`
+	Stake           sdk.Coins      `json:"stake" yaml:"stake"`
+	Status          NodeStatus     `json:"status" yaml:"status"`
+	Height          int64          `json:"height" yaml:"height"`
+}
+
+func NewNode(did, serviceEndpoint string, operator sdk.AccAddress, stake sdk.Coins, height int64) Node {
+	return Node{
+		Did:             did,
+		ServiceEndpoint: serviceEndpoint,
+		Operator:        operator,
+		Stake:           stake,
+		Status:          NodeActive,
+		Height:          height,
+	}
+}
+
+func (n Node) IsActive() bool {
+	return n.Status == NodeActive
+}
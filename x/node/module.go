@@ -3,6 +3,7 @@ package node
 import (
 	"encoding/json"
 	
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,10 @@ import (
 	"github.com/spf13/cobra"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	
+	"github.com/ixofoundation/ixo-cosmos/x/did"
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
+	"github.com/ixofoundation/ixo-cosmos/x/node/client/cli"
+	"github.com/ixofoundation/ixo-cosmos/x/node/client/rest"
 	"github.com/ixofoundation/ixo-cosmos/x/node/internal/keeper"
 )
 
@@ -26,6 +31,7 @@ func (AppModuleBasic) Name() string {
 }
 
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
 }
 
 func (AppModuleBasic) DefaultGenesis() json.RawMessage {
@@ -33,29 +39,65 @@ func (AppModuleBasic) DefaultGenesis() json.RawMessage {
 }
 
 func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
-	return nil
+	var data GenesisState
+	err := ModuleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	
+	return ValidateGenesis(data)
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	rest.RegisterRoutes(ctx, rtr)
 }
 
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	nodeTxCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "node transaction sub commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	
+	nodeTxCmd.AddCommand(client.PostCommands(didcli.FromDidCommands(
+		cli.RegisterNodeCmd(cdc),
+		cli.UpdateNodeCmd(cdc),
+		cli.DeregisterNodeCmd(cdc),
+	)...)...)
+	
+	return nodeTxCmd
 }
 
 func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	nodeQueryCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "node query sub commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	
+	nodeQueryCmd.AddCommand(client.GetCommands(
+		cli.GetNodeCmd(cdc),
+		cli.GetNodesCmd(cdc),
+	)...)
+	
+	return nodeQueryCmd
 }
 
 type AppModule struct {
 	AppModuleBasic
-	keeper keeper.Keeper
+	keeper    keeper.Keeper
+	didKeeper did.Keeper
 }
 
-func NewAppModule(keeper Keeper) AppModule {
+func NewAppModule(keeper Keeper, didKeeper did.Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
+		didKeeper:      didKeeper,
 	}
 }
 
@@ -70,7 +112,7 @@ func (AppModule) Route() string {
 }
 
 func (am AppModule) NewHandler() sdk.Handler {
-	return nil
+	return NewHandler(am.keeper, am.didKeeper)
 }
 
 func (AppModule) QuerierRoute() string {
@@ -78,7 +120,7 @@ func (AppModule) QuerierRoute() string {
 }
 
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return nil
+	return NewQuerier(am.keeper)
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abciTypes.ValidatorUpdate {
@@ -91,7 +133,7 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abciTyp
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	return nil
+	return ModuleCdc.MustMarshalJSON(WriteGenesis(ctx, am.keeper))
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abciTypes.RequestBeginBlock) {
@@ -16,4 +16,5 @@ type ETHGenesisState struct {
 
 type GenesisState struct {
 	ETHGenesisStates []ETHGenesisState
+	Nodes            []Node `json:"nodes"`
 }
@@ -14,23 +14,67 @@ const (
 	DefaultCodeSpace = types.DefaultCodeSpace
 	PaidoutStatus    = types.PaidoutStatus
 	FundedStatus     = types.FundedStatus
+
+	WithdrawalPending   = types.WithdrawalPending
+	WithdrawalSubmitted = types.WithdrawalSubmitted
+	WithdrawalConfirmed = types.WithdrawalConfirmed
+	WithdrawalFailed    = types.WithdrawalFailed
+
+	EthDepositPending  = types.EthDepositPending
+	EthDepositApplied  = types.EthDepositApplied
+	EthDepositRejected = types.EthDepositRejected
+
+	IxoAccountFeesId               = types.IxoAccountFeesId
+	IxoAccountPayFeesId            = types.IxoAccountPayFeesId
+	InitiatingNodeAccountPayFeesId = types.InitiatingNodeAccountPayFeesId
+	ValidatingNodeSetAccountFeesId = types.ValidatingNodeSetAccountFeesId
+
+	FeeQuoteClaim             = types.FeeQuoteClaim
+	FeeQuoteEvaluation        = types.FeeQuoteEvaluation
+	FeeQuoteAgentRegistration = types.FeeQuoteAgentRegistration
+	EvaluatorAccountID        = types.EvaluatorAccountID
+	ValidatorsFeeRecipient    = types.ValidatorsFeeRecipient
+	CommunityPoolFeeRecipient = types.CommunityPoolFeeRecipient
+
+	StoreVersion = keeper.StoreVersion
 )
 
 type (
-	Keeper                 = keeper.Keeper
-	CreateProjectMsg       = types.CreateProjectMsg
-	UpdateProjectStatusMsg = types.UpdateProjectStatusMsg
-	CreateAgentMsg         = types.CreateAgentMsg
-	UpdateAgentMsg         = types.UpdateAgentMsg
-	CreateClaimMsg         = types.CreateClaimMsg
-	CreateEvaluationMsg    = types.CreateEvaluationMsg
-	WithdrawFundsMsg       = types.WithdrawFundsMsg
-	StoredProjectDoc       = types.StoredProjectDoc
-	WithdrawalInfo         = types.WithdrawalInfo
-	AccountMap             = types.AccountMap
+	Keeper                    = keeper.Keeper
+	CreateProjectMsg          = types.CreateProjectMsg
+	UpdateProjectStatusMsg    = types.UpdateProjectStatusMsg
+	UpdateProjectDocMsg       = types.UpdateProjectDocMsg
+	UpdateProjectDoc          = types.UpdateProjectDoc
+	ProjectDocVersion         = types.ProjectDocVersion
+	QueryProjectsParams       = types.QueryProjectsParams
+	QueryProjectsResult       = types.QueryProjectsResult
+	CreateAgentMsg            = types.CreateAgentMsg
+	UpdateAgentMsg            = types.UpdateAgentMsg
+	CreateClaimMsg            = types.CreateClaimMsg
+	CreateEvaluationMsg       = types.CreateEvaluationMsg
+	WithdrawFundsMsg          = types.WithdrawFundsMsg
+	UpdateWithdrawalStatusMsg = types.UpdateWithdrawalStatusMsg
+	MsgAttestEthDeposit       = types.MsgAttestEthDeposit
+	StoredProjectDoc          = types.StoredProjectDoc
+	WithdrawalInfo            = types.WithdrawalInfo
+	Withdrawal                = types.Withdrawal
+	WithdrawalStatus          = types.WithdrawalStatus
+	EthDeposit                = types.EthDeposit
+	AccountMap                = types.AccountMap
+	InternalAccountID         = types.InternalAccountID
+	FeeQuote                  = types.FeeQuote
+	FeePayment                = types.FeePayment
+	GenesisState              = types.GenesisState
 )
 
 var (
-	NewKeeper = keeper.NewKeeper
-	ModuleCdc = types.ModuleCdc
+	NewKeeper     = keeper.NewKeeper
+	NewEthDeposit = types.NewEthDeposit
+
+	NewQueryProjectsParams = types.NewQueryProjectsParams
+	ModuleCdc              = types.ModuleCdc
+
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
 )
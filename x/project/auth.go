@@ -1,8 +1,8 @@
 package project
 
 import (
-	"github.com/btcsuite/btcutil/base58"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/ixofoundation/ixo-cosmos/x/did"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
@@ -19,23 +19,23 @@ func NewAnteHandler(projectKeeper Keeper, didKeeper did.Keeper) sdk.AnteHandler
 
 		msg := ixoTx.GetMsgs()[0]
 		projectMsg := msg.(types.ProjectMsg)
-		pubKey := [32]byte{}
+		var pubKeys []crypto.PubKey
 
 		if projectMsg.IsNewDid() {
 			createProjectMsg := msg.(types.CreateProjectMsg)
-			copy(pubKey[:], base58.Decode(createProjectMsg.GetPubKey()))
+			pubKeys = []crypto.PubKey{ixo.Ed25519PubKey(createProjectMsg.GetPubKey())}
 
 		} else {
 			if projectMsg.IsWithdrawal() {
 				did := ixo.Did(msg.GetSigners()[0])
-				didDoc, _ := didKeeper.GetDidDoc(ctx, did)
-				if didDoc == nil {
+				authKeys, err := didKeeper.GetSigningKeys(ctx, did, msg)
+				if err != nil {
 					return ctx,
 						sdk.ErrUnauthorized("Issuer did not found").Result(),
 						true
 				}
 
-				copy(pubKey[:], base58.Decode(didDoc.GetPubKey()))
+				pubKeys = authKeys
 			} else {
 				projectDid := ixo.Did(msg.GetSigners()[0])
 				projectDoc, err := projectKeeper.GetProjectDoc(ctx, projectDid)
@@ -43,7 +43,10 @@ func NewAnteHandler(projectKeeper Keeper, didKeeper did.Keeper) sdk.AnteHandler
 					return ctx, sdk.ErrInternal("project did not found").Result(), false
 				}
 
-				copy(pubKey[:], base58.Decode(projectDoc.GetPubKey()))
+				// The project DID doc, if any, adds its own keys and those of its
+				// controllers and delegates
+				authKeys, _ := didKeeper.GetSigningKeys(ctx, projectDid, msg)
+				pubKeys = append([]crypto.PubKey{ixo.Ed25519PubKey(projectDoc.GetPubKey())}, authKeys...)
 			}
 		}
 
@@ -53,7 +56,7 @@ func NewAnteHandler(projectKeeper Keeper, didKeeper did.Keeper) sdk.AnteHandler
 				sdk.ErrUnauthorized("there can only be one signer").Result(),
 				true
 		}
-		res := ixo.VerifySignature(msg, pubKey, sigs[0])
+		res := ixo.VerifySignatureWithAnyPubKey(msg, pubKeys, sigs[0])
 
 		if !res {
 			return ctx, sdk.ErrInternal("Signature Verification failed").Result(), true
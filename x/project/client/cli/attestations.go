@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/ixofoundation/ixo-cosmos/x/contracts"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/keeper"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+// ethDepositSource looks up project funding transfers using an Ethereum node
+type ethDepositSource struct {
+	ethClient               ixo.EthClient
+	ixoTokenContractAddress string
+	registryContractAddress string
+}
+
+var _ types.EthDepositSource = ethDepositSource{}
+
+func (s ethDepositSource) GetProjectFunding(ethTxHash string, projectDid ixo.Did) (string, sdk.Int, error) {
+	projectEthWallet, amount, err := s.ethClient.GetProjectFunding(
+		s.ixoTokenContractAddress, s.registryContractAddress, ethTxHash, projectDid)
+	if err != nil {
+		return "", sdk.Int{}, err
+	}
+
+	return projectEthWallet, sdk.NewInt(amount), nil
+}
+
+func newEthDepositSource(ctx context.CLIContext) (types.EthDepositSource, error) {
+	bz, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", contracts.QuerierRoute,
+		contracts.QueryAllContracts), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ethContracts := make(map[string]string)
+	err = json.Unmarshal(bz, &ethContracts)
+	if err != nil {
+		return nil, err
+	}
+
+	ethClient, err := ixo.NewEthClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return ethDepositSource{
+		ethClient:               ethClient,
+		ixoTokenContractAddress: ethContracts[contracts.KeyIxoTokenContractAddress],
+		registryContractAddress: ethContracts[contracts.KeyProjectRegistryContractAddress],
+	}, nil
+}
+
+// AttestEthDepositsCmd looks up every pending project funding transfer that
+// the validator has not attested to yet on Ethereum, and attests to it
+func AttestEthDepositsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attestEthDeposits",
+		Short: "Attest to the pending project funding transactions, signed by the validator operator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			source, err := newEthDepositSource(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposits, err := queryPendingEthDeposits(cdc, cliCtx)
+			if err != nil {
+				return err
+			}
+
+			validator := sdk.ValAddress(cliCtx.GetFromAddress())
+			for _, msg := range attestEthDeposits(source, validator, deposits) {
+				err = utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// attestEthDeposits returns the validator's attestations to the deposits that
+// it has not attested to yet. Deposits that cannot be found in the source, or
+// are not valid project funding transfers, are skipped.
+func attestEthDeposits(source types.EthDepositSource, validator sdk.ValAddress,
+	deposits []types.EthDeposit) (msgs []types.MsgAttestEthDeposit) {
+
+	for _, deposit := range deposits {
+		if deposit.HasAttestationFrom(validator) {
+			continue
+		}
+
+		projectEthWallet, amount, err := source.GetProjectFunding(deposit.EthTxHash, deposit.ProjectDid)
+		if err != nil {
+			fmt.Printf("Could not verify eth deposit %s: %s\n", deposit.EthTxHash, err)
+			continue
+		}
+
+		msgs = append(msgs, types.NewMsgAttestEthDeposit(validator, deposit.ProjectDid,
+			deposit.EthTxHash, projectEthWallet, amount))
+	}
+
+	return msgs
+}
+
+func queryPendingEthDeposits(cdc *codec.Codec, ctx context.CLIContext) ([]types.EthDeposit, error) {
+	res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+		keeper.QueryPendingEthDeposits), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var deposits []types.EthDeposit
+	err = cdc.UnmarshalJSON(res, &deposits)
+	if err != nil {
+		return nil, err
+	}
+
+	return deposits, nil
+}
@@ -0,0 +1,11 @@
+package cli
+
+const (
+	FlagStatus  = "status"
+	FlagCreator = "creator"
+	FlagNode    = "node-did"
+	FlagPage    = "page"
+	FlagLimit   = "limit"
+
+	FlagProjectDid = "project-did"
+)
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 	"github.com/ixofoundation/ixo-cosmos/x/project/internal/keeper"
@@ -52,6 +53,94 @@ func GetProjectDocCmd(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetProjectDocHistoryCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getProjectDocHistory [did]",
+		Short: "Query the previous versions of the ProjectDoc for a DID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide a did")
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryProjectDocHistory, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var history []types.ProjectDocVersion
+			err = cdc.UnmarshalJSON(res, &history)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(history, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetProjectDocsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "getProjects",
+		Aliases: []string{"projects"},
+		Short:   "Query ProjectDocs filtered by status, creator DID and node DID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			params := types.NewQueryProjectsParams(
+				types.ProjectStatus(viper.GetString(FlagStatus)),
+				viper.GetString(FlagCreator), viper.GetString(FlagNode),
+				viper.GetUint64(FlagPage), viper.GetUint64(FlagLimit))
+			if err := params.ValidatePagination(); err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute,
+				keeper.QueryProjectDocs), bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryProjectsResult
+			err = cdc.UnmarshalJSON(res, &result)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "Only list projects with this status (e.g. STARTED)")
+	cmd.Flags().String(FlagCreator, "", "Only list projects created by this DID")
+	cmd.Flags().String(FlagNode, "", "Only list projects hosted on this node DID")
+	cmd.Flags().Uint64(FlagPage, 1, "Page of results to return")
+	cmd.Flags().Uint64(FlagLimit, types.DefaultProjectsPerPage, "Number of projects per page")
+
+	return cmd
+}
+
 func GetProjectAccountsCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "getProjectAccounts [did]",
@@ -94,6 +183,38 @@ func GetProjectAccountsCmd(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func GetMigratedAccountCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getMigratedAccount [old-address-hex]",
+		Short: "Get the address that a project account has been moved to from its old hex-encoded address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryMigratedAccount, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var migratedAccount types.MigratedAccount
+			err = cdc.UnmarshalJSON(res, &migratedAccount)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(migratedAccount, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
 func GetProjectTxsCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "getProjectTxs [project-did]",
@@ -133,3 +254,176 @@ func GetProjectTxsCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetWithdrawalCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getWithdrawal [id]",
+		Short: "Get a queued withdrawal by its id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide a withdrawal id")
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryWithdrawal, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var withdrawal types.Withdrawal
+			err = cdc.UnmarshalJSON(res, &withdrawal)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(withdrawal, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetWithdrawalsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getWithdrawals [status]",
+		Short: "Get the queued withdrawals with a status (pending, submitted, confirmed or failed)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide a withdrawal status")
+			}
+
+			withdrawals, err := queryWithdrawals(cdc, ctx, types.WithdrawalStatus(args[0]))
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(withdrawals, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetFeeQuoteCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getFeeQuote [project-did] [msg-type]",
+		Short: "Get the fees paid by a project for a msg type (claim, evaluation or agentRegistration)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+				return errors.New("You must provide a project did and msg type")
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryFeeQuote, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+
+			var quote types.FeeQuote
+			err = cdc.UnmarshalJSON(res, &quote)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(quote, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func queryWithdrawals(cdc *codec.Codec, ctx context.CLIContext, status types.WithdrawalStatus) ([]types.Withdrawal, error) {
+	res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute,
+		keeper.QueryWithdrawals, status), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var withdrawals []types.Withdrawal
+	err = cdc.UnmarshalJSON(res, &withdrawals)
+	if err != nil {
+		return nil, err
+	}
+
+	return withdrawals, nil
+}
+
+func GetEthDepositCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getEthDeposit [project-did] [eth-tx-hash]",
+		Short: "Get a project funding transaction and its validator attestations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+				return errors.New("You must provide a project did and an eth tx hash")
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute,
+				keeper.QueryEthDeposit, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+
+			var deposit types.EthDeposit
+			err = cdc.UnmarshalJSON(res, &deposit)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(deposit, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
+
+func GetPendingEthDepositsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "getPendingEthDeposits",
+		Short: "Get the project funding transactions that are waiting for a quorum of attestations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			deposits, err := queryPendingEthDeposits(cdc, ctx)
+			if err != nil {
+				return err
+			}
+
+			output, err := json.MarshalIndent(deposits, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
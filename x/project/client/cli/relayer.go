@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ixofoundation/ixo-cosmos/x/contracts"
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+const FlagPollInterval = "poll-interval"
+
+// RelayWithdrawalsCmd runs the withdrawal relayer, which submits the queued
+// withdrawals to Ethereum and reports their progress back to the chain. The
+// Ethereum transactions are signed with the local ethWallet.json.
+func RelayWithdrawalsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayWithdrawals",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit queued withdrawals to Ethereum and report their status, signed by the sovrinDID of the withdrawal relayer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithBroadcastMode(flags.BroadcastBlock)
+
+			relayerDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			pollInterval := viper.GetDuration(FlagPollInterval)
+
+			ethClient, err := ixo.NewEthClient()
+			if err != nil {
+				return err
+			}
+
+			relayer := newWithdrawalRelayer(cdc, ctx, ethClient, relayerDid)
+			for {
+				err = relayer.relay()
+				if err != nil {
+					fmt.Println("Failed to relay withdrawals:", err)
+				}
+				time.Sleep(pollInterval)
+			}
+		},
+	}
+
+	cmd.Flags().Duration(FlagPollInterval, 10*time.Second, "Time to wait between polls of the withdrawal queue")
+	return cmd
+}
+
+// withdrawalRelayer keeps the withdrawals that it has submitted to Ethereum
+// but that are still pending on chain, so that they are not submitted again
+// while the status update is retried
+type withdrawalRelayer struct {
+	cdc        *codec.Codec
+	ctx        context.CLIContext
+	ethClient  ixo.EthClient
+	relayerDid sovrin.SovrinDid
+	inFlight   map[uint64]types.UpdateWithdrawalStatusDoc
+}
+
+func newWithdrawalRelayer(cdc *codec.Codec, ctx context.CLIContext, ethClient ixo.EthClient,
+	relayerDid sovrin.SovrinDid) *withdrawalRelayer {
+
+	return &withdrawalRelayer{
+		cdc:        cdc,
+		ctx:        ctx,
+		ethClient:  ethClient,
+		relayerDid: relayerDid,
+		inFlight:   make(map[uint64]types.UpdateWithdrawalStatusDoc),
+	}
+}
+
+func (r *withdrawalRelayer) relay() error {
+	bz, _, err := r.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", contracts.QuerierRoute,
+		contracts.QueryAllContracts), nil)
+	if err != nil {
+		return err
+	}
+
+	ethContracts := make(map[string]string)
+	err = json.Unmarshal(bz, &ethContracts)
+	if err != nil {
+		return err
+	}
+	authContract := ethContracts[contracts.KeyAuthContractAddress]
+
+	pending, err := queryWithdrawals(r.cdc, r.ctx, types.WithdrawalPending)
+	if err != nil {
+		return err
+	}
+
+	// Withdrawals that are no longer pending have had their status updated
+	stillPending := make(map[uint64]bool)
+	for _, w := range pending {
+		stillPending[w.ID] = true
+	}
+	for id := range r.inFlight {
+		if !stillPending[id] {
+			delete(r.inFlight, id)
+		}
+	}
+
+	// Submit pending withdrawals. A withdrawal that was already submitted
+	// only has its status update retried. The auth contract performs each
+	// action ID only once, so a withdrawal that is submitted again after the
+	// relayer restarts is not paid out twice.
+	for _, w := range pending {
+		doc, submitted := r.inFlight[w.ID]
+		if !submitted {
+			projectEthWallet, err := r.ethClient.ProjectWalletFromRegistry(
+				ethContracts[contracts.KeyProjectRegistryContractAddress], w.ProjectDid)
+			if err != nil {
+				fmt.Printf("Could not find Ethereum wallet of project %s: %s\n", w.ProjectDid, err)
+				continue
+			}
+
+			ethTxHash, err := r.ethClient.SubmitTokenTransfer(authContract,
+				ethContracts[contracts.KeyProjectWalletAuthoriserContractAddress],
+				w.ActionID(), projectEthWallet, w.RecipientEthAddress, w.Amount.Amount.Int64())
+			if err != nil {
+				fmt.Printf("Could not submit withdrawal %d: %s\n", w.ID, err)
+				continue
+			}
+
+			doc = types.UpdateWithdrawalStatusDoc{
+				WithdrawalID:     w.ID,
+				Status:           types.WithdrawalSubmitted,
+				ProjectEthWallet: projectEthWallet,
+				EthTxHash:        ethTxHash,
+			}
+			r.inFlight[w.ID] = doc
+		}
+
+		msg := types.NewUpdateWithdrawalStatusMsg(r.relayerDid.Did, doc)
+		err = IxoSignAndBroadcast(r.cdc, r.ctx, msg, r.relayerDid)
+		if err != nil {
+			fmt.Printf("Could not report withdrawal %d as submitted: %s\n", w.ID, err)
+		}
+	}
+
+	// Confirm or fail submitted withdrawals once their transaction is mined.
+	// A transaction can fail after an earlier one with the same action ID
+	// paid out, so a withdrawal only fails if its action was not triggered.
+	submitted, err := queryWithdrawals(r.cdc, r.ctx, types.WithdrawalSubmitted)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range submitted {
+		mined, succeeded, err := r.ethClient.GetTransactionStatus(w.EthTxHash)
+		if err != nil {
+			fmt.Printf("Could not get status of withdrawal %d: %s\n", w.ID, err)
+			continue
+		} else if !mined {
+			continue
+		}
+
+		triggered, err := r.ethClient.IsActionTriggered(authContract, w.ActionID())
+		if err != nil {
+			fmt.Printf("Could not get action of withdrawal %d: %s\n", w.ID, err)
+			continue
+		}
+
+		doc := types.UpdateWithdrawalStatusDoc{
+			WithdrawalID: w.ID,
+			Status:       types.WithdrawalConfirmed,
+		}
+		if !triggered && succeeded {
+			// The action is still waiting for confirmations by other members
+			continue
+		} else if !triggered {
+			doc.Status = types.WithdrawalFailed
+			doc.FailureReason = "Ethereum transaction " + w.EthTxHash + " failed"
+		}
+
+		msg := types.NewUpdateWithdrawalStatusMsg(r.relayerDid.Did, doc)
+		err = IxoSignAndBroadcast(r.cdc, r.ctx, msg, r.relayerDid)
+		if err != nil {
+			fmt.Printf("Could not report status of withdrawal %d: %s\n", w.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -4,29 +4,25 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/btcsuite/btcutil/base58"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo/sovrin"
 	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
 )
 
 func IxoSignAndBroadcast(cdc *codec.Codec, ctx context.CLIContext, msg sdk.Msg, sovrinDid sovrin.SovrinDid) error {
-	privKey := [64]byte{}
-	copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
-	copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
-
-	msgBytes, err := json.Marshal(msg)
+	signature, err := didcli.SignIxoMsg(msg, sovrinDid)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	signature := ixo.SignIxoMessage(msgBytes, sovrinDid.Did, privKey)
 	tx := ixo.NewIxoTxSingleMsg(msg, signature)
 
 	bz, err := cdc.MarshalJSON(tx)
@@ -40,40 +36,55 @@ func IxoSignAndBroadcast(cdc *codec.Codec, ctx context.CLIContext, msg sdk.Msg,
 	}
 
 	fmt.Println(res.String())
+	if res.Code != 0 {
+		return fmt.Errorf("tx %s was rejected with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
 	fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.TxHash)
 	return nil
 
 }
 
-func unmarshalSovrinDID(sovrinJson string) sovrin.SovrinDid {
-	sovrinDid := sovrin.SovrinDid{}
-	sovrinErr := json.Unmarshal([]byte(sovrinJson), &sovrinDid)
-	if sovrinErr != nil {
-		panic(sovrinErr)
+// ProjectDidCommands adds the --project-did flag to the commands on an
+// existing project, so that its controllers and delegates can sign them
+func ProjectDidCommands(cmds ...*cobra.Command) []*cobra.Command {
+	for _, cmd := range cmds {
+		cmd.Flags().String(FlagProjectDid, "", "DID of the project, if the tx is not signed by the project's own sovrin DID")
 	}
+	return cmds
+}
 
-	return sovrinDid
+// getProjectDid returns the --project-did, or the signing DID if it is not set
+func getProjectDid(signer sovrin.SovrinDid) ixo.Did {
+	if projectDid := viper.GetString(FlagProjectDid); projectDid != "" {
+		return projectDid
+	}
+	return signer.Did
 }
 
 func CreateProjectCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "createProject [project-json] [sovrin-did]",
+		Use:   "createProject [project-json]",
 		Short: "Create a new ProjectDoc signed by the sovrinDID of the project",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+			if len(args[0]) == 0 {
 				return errors.New("You must provide the project data and the projects private key")
 			}
 
 			projectDoc := types.ProjectDoc{}
 			err := json.Unmarshal([]byte(args[0]), &projectDoc)
 			if err != nil {
-				panic(err)
+				return err
+			}
+
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
 			}
 
-			sovrinDid := unmarshalSovrinDID(args[1])
 			msg := types.NewCreateProjectMsg(projectDoc, sovrinDid)
 
 			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
@@ -83,13 +94,14 @@ func CreateProjectCmd(cdc *codec.Codec) *cobra.Command {
 
 func UpdateProjectStatusCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "updateProjectStatus [tx-hash] [sender-did] [status] [sovrin-did]",
-		Short: "Update the status of a project signed by the sovrinDID of the project",
+		Use:   "updateProjectStatus [tx-hash] [sender-did] [status]",
+		Short: "Update the status of a project signed by the sovrinDID of the project, or of a controller or delegate of the --project-did",
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 4 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 {
+			if len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 {
 				return errors.New("You must provide the status and the projects private key")
 			}
 
@@ -112,8 +124,46 @@ func UpdateProjectStatusCmd(cdc *codec.Codec) *cobra.Command {
 				EthFundingTxnID: txHash,
 			}
 
-			sovrinDid := unmarshalSovrinDID(args[3])
-			msg := types.NewUpdateProjectStatusMsg(txHash, senderDid, updateProjectStatusDoc, sovrinDid)
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewUpdateProjectStatusMsg(txHash, senderDid, updateProjectStatusDoc, getProjectDid(sovrinDid))
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
+		},
+	}
+}
+
+func UpdateProjectDocCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "updateProjectDoc [tx-hash] [sender-did] [update-json]",
+		Short: "Update the service endpoint, evaluator pay and required claims of an unfunded project, signed by the sovrinDID of the project, or of a controller or delegate of the --project-did",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			if len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 {
+				return errors.New("You must provide the updated fields and the projects private key")
+			}
+
+			txHash := args[0]
+			senderDid := args[1]
+
+			updateProjectDoc := types.UpdateProjectDoc{}
+			err := json.Unmarshal([]byte(args[2]), &updateProjectDoc)
+			if err != nil {
+				return err
+			}
+
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewUpdateProjectDocMsg(txHash, senderDid, updateProjectDoc, getProjectDid(sovrinDid))
 
 			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
 		},
@@ -122,15 +172,15 @@ func UpdateProjectStatusCmd(cdc *codec.Codec) *cobra.Command {
 
 func CreateAgentCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "createAgent [tx-hash] [sender-did] [agent-did] [role] [project-did]",
-		Short: "Create a new agent on a project signed by the sovrinDID of the project",
+		Use:   "createAgent [tx-hash] [sender-did] [agent-did] [role]",
+		Short: "Create a new agent on a project signed by the sovrinDID of the project, or of a controller or delegate of the --project-did",
+		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 5 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 ||
-				len(args[4]) == 0 {
-				return errors.New("You must provide the agentDid, role and the projects private key")
+			if len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 {
+				return errors.New("You must provide the txHash, senderDid, agentDid and role")
 			}
 
 			txHash := args[0]
@@ -146,8 +196,12 @@ func CreateAgentCmd(cdc *codec.Codec) *cobra.Command {
 				Role:     role,
 			}
 
-			sovrinDid := unmarshalSovrinDID(args[4])
-			msg := types.NewCreateAgentMsg(txHash, senderDid, createAgentDoc, sovrinDid)
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewCreateAgentMsg(txHash, senderDid, createAgentDoc, getProjectDid(sovrinDid))
 
 			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
 		},
@@ -156,14 +210,15 @@ func CreateAgentCmd(cdc *codec.Codec) *cobra.Command {
 
 func UpdateAgentCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "updateAgent [tx-hash] [sender-did] [agent-did] [status] [sovrin-did]",
-		Short: "Update the status of an agent on a project signed by the sovrinDID of the project",
+		Use:   "updateAgent [tx-hash] [sender-did] [agent-did] [status] [role]",
+		Short: "Update the status of an agent on a project signed by the sovrinDID of the project, or of a controller or delegate of the --project-did",
+		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 6 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 ||
-				len(args[4]) == 0 || len(args[5]) == 0 {
+			if len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 ||
+				len(args[4]) == 0 {
 				return errors.New("You must provide the agentDid, status and the projects private key")
 			}
 
@@ -182,8 +237,12 @@ func UpdateAgentCmd(cdc *codec.Codec) *cobra.Command {
 				Role:   agentRole,
 			}
 
-			sovrinDid := unmarshalSovrinDID(args[5])
-			msg := types.NewUpdateAgentMsg(txHash, senderDid, updateAgentDoc, sovrinDid)
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewUpdateAgentMsg(txHash, senderDid, updateAgentDoc, getProjectDid(sovrinDid))
 
 			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
 		},
@@ -192,13 +251,14 @@ func UpdateAgentCmd(cdc *codec.Codec) *cobra.Command {
 
 func CreateClaimCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "createClaim [tx-hash] [sender-did] [claim-id] [sovrin-did]",
-		Short: "Create a new claim on a project signed by the sovrinDID of the project",
+		Use:   "createClaim [tx-hash] [sender-did] [claim-id]",
+		Short: "Create a new claim on a project signed by the sovrinDID of the project, or of a controller or delegate of the --project-did",
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 4 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 {
+			if len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 {
 				return errors.New("You must provide the claimId and the projects private key")
 			}
 
@@ -209,8 +269,12 @@ func CreateClaimCmd(cdc *codec.Codec) *cobra.Command {
 				ClaimID: claimId,
 			}
 
-			sovrinDid := unmarshalSovrinDID(args[3])
-			msg := types.NewCreateClaimMsg(txHash, senderDid, createClaimDoc, sovrinDid)
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewCreateClaimMsg(txHash, senderDid, createClaimDoc, getProjectDid(sovrinDid))
 
 			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
 		},
@@ -219,14 +283,14 @@ func CreateClaimCmd(cdc *codec.Codec) *cobra.Command {
 
 func CreateEvaluationCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "createEvaluation [tx-hash] [sender-did] [claim-id] [status] [sovrin-did]",
-		Short: "Create a new claim evaluation on a project signed by the sovrinDID of the project",
+		Use:   "createEvaluation [tx-hash] [sender-did] [claim-id] [status]",
+		Short: "Create a new claim evaluation on a project signed by the sovrinDID of the project, or of a controller or delegate of the --project-did",
+		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 5 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 ||
-				len(args[4]) == 0 {
+			if len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 || len(args[3]) == 0 {
 				return errors.New("You must provide the claimId, status and the projects private key")
 			}
 
@@ -243,8 +307,12 @@ func CreateEvaluationCmd(cdc *codec.Codec) *cobra.Command {
 				Status:  claimStatus,
 			}
 
-			sovrinDid := unmarshalSovrinDID(args[4])
-			msg := types.NewCreateEvaluationMsg(txHash, senderDid, createEvaluationDoc, sovrinDid)
+			sovrinDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewCreateEvaluationMsg(txHash, senderDid, createEvaluationDoc, getProjectDid(sovrinDid))
 
 			return IxoSignAndBroadcast(cdc, ctx, msg, sovrinDid)
 		},
@@ -253,19 +321,20 @@ func CreateEvaluationCmd(cdc *codec.Codec) *cobra.Command {
 
 func WithDrawFundsCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "withdrawFunds [sender-did] [data]",
+		Use:   "withdrawFunds [data]",
+		Args:  cobra.ExactArgs(1),
 		Short: "withdraw funds.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCLIContext().
 				WithCodec(cdc)
 
-			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
-				return errors.New("You must provide the sender did and data.")
+			senderDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
 			}
 
-			senderDid := unmarshalSovrinDID(args[0])
 			var data types.WithdrawFundsDoc
-			err := json.Unmarshal([]byte(args[1]), &data)
+			err = json.Unmarshal([]byte(args[0]), &data)
 			if err != nil {
 				return err
 			}
@@ -276,3 +345,30 @@ func WithDrawFundsCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func UpdateWithdrawalStatusCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "updateWithdrawalStatus [data]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update the status of a queued withdrawal signed by the sovrinDID of the withdrawal relayer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().
+				WithCodec(cdc)
+
+			relayerDid, err := didcli.GetFromDid()
+			if err != nil {
+				return err
+			}
+
+			var data types.UpdateWithdrawalStatusDoc
+			err = json.Unmarshal([]byte(args[0]), &data)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewUpdateWithdrawalStatusMsg(relayerDid.Did, data)
+
+			return IxoSignAndBroadcast(cdc, ctx, msg, relayerDid)
+		},
+	}
+}
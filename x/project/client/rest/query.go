@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,8 +23,16 @@ type AccDetails struct {
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/project/{did}", queryProjectDocRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projects", queryProjectDocsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/projectDocHistory/{did}", queryProjectDocHistoryRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/projectAccounts/{projectDid}", queryProjectAccountsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/migratedAccount/{oldAddress}", queryMigratedAccountRequestHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/projectTxs/{projectDid}", queryProjectTxsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/withdrawal/{id}", queryWithdrawalRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/withdrawals/{status}", queryWithdrawalsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/ethDeposit/{projectDid}/{ethTxHash}", queryEthDepositRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/pendingEthDeposits", queryPendingEthDepositsRequestHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/feeQuote/{projectDid}/{msgType}", queryFeeQuoteRequestHandler(cliCtx)).Methods("GET")
 }
 
 func queryProjectDocRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -56,6 +65,79 @@ func queryProjectDocRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func queryProjectDocsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+
+		page, limit := uint64(1), types.DefaultProjectsPerPage
+		var err error
+		if pageStr := query.Get("page"); pageStr != "" {
+			if page, err = strconv.ParseUint(pageStr, 10, 64); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(fmt.Sprintf("Invalid page. Error: %s", err.Error())))
+
+				return
+			}
+		}
+		if limitStr := query.Get("limit"); limitStr != "" {
+			if limit, err = strconv.ParseUint(limitStr, 10, 64); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(fmt.Sprintf("Invalid limit. Error: %s", err.Error())))
+
+				return
+			}
+		}
+
+		params := types.NewQueryProjectsParams(types.ProjectStatus(query.Get("status")),
+			query.Get("creator"), query.Get("node"), page, limit)
+		if err := params.ValidatePagination(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s",
+			types.QuerierRoute, keeper.QueryProjectDocs), cliCtx.Codec.MustMarshalJSON(params))
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query projects. Error: %s", err.Error())))
+
+			return
+		}
+
+		var result types.QueryProjectsResult
+		cliCtx.Codec.MustUnmarshalJSON(res, &result)
+
+		bz, err := json.Marshal(result)
+		_, _ = w.Write(bz)
+	}
+}
+
+func queryProjectDocHistoryRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		did := vars["did"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryProjectDocHistory, did), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query project doc history. Error: %s", err.Error())))
+
+			return
+		}
+
+		var history []types.ProjectDocVersion
+		cliCtx.Codec.MustUnmarshalJSON(res, &history)
+
+		bz, err := json.Marshal(history)
+		_, _ = w.Write(bz)
+	}
+}
+
 func queryProjectAccountsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -122,3 +204,141 @@ func queryProjectTxsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 
 }
+
+func queryWithdrawalRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		id := vars["id"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryWithdrawal, id), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query withdrawal. Error: %s", err.Error())))
+
+			return
+		}
+
+		var withdrawal types.Withdrawal
+		cliCtx.Codec.MustUnmarshalJSON(res, &withdrawal)
+
+		bz, err := json.Marshal(withdrawal)
+		_, _ = w.Write(bz)
+	}
+}
+
+func queryWithdrawalsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		status := vars["status"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryWithdrawals, status), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query withdrawals. Error: %s", err.Error())))
+
+			return
+		}
+
+		withdrawals := []types.Withdrawal{}
+		cliCtx.Codec.MustUnmarshalJSON(res, &withdrawals)
+
+		bz, err := json.Marshal(withdrawals)
+		_, _ = w.Write(bz)
+	}
+}
+
+func queryMigratedAccountRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		oldAddress := vars["oldAddress"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryMigratedAccount, oldAddress), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query migrated account. Error: %s", err.Error())))
+
+			return
+		}
+
+		var migratedAccount types.MigratedAccount
+		cliCtx.Codec.MustUnmarshalJSON(res, &migratedAccount)
+
+		bz, err := json.Marshal(migratedAccount)
+		_, _ = w.Write(bz)
+	}
+}
+
+func queryEthDepositRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		projectDid := vars["projectDid"]
+		ethTxHash := vars["ethTxHash"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryEthDeposit, projectDid, ethTxHash), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query eth deposit. Error: %s", err.Error())))
+
+			return
+		}
+
+		var deposit types.EthDeposit
+		cliCtx.Codec.MustUnmarshalJSON(res, &deposit)
+
+		bz, err := json.Marshal(deposit)
+		_, _ = w.Write(bz)
+	}
+}
+
+func queryPendingEthDepositsRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s",
+			types.QuerierRoute, keeper.QueryPendingEthDeposits), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query eth deposits. Error: %s", err.Error())))
+
+			return
+		}
+
+		deposits := []types.EthDeposit{}
+		cliCtx.Codec.MustUnmarshalJSON(res, &deposits)
+
+		bz, err := json.Marshal(deposits)
+		_, _ = w.Write(bz)
+	}
+}
+
+func queryFeeQuoteRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		vars := mux.Vars(r)
+		projectDid := vars["projectDid"]
+		msgType := vars["msgType"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s",
+			types.QuerierRoute, keeper.QueryFeeQuote, projectDid, msgType), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(fmt.Sprintf("Could't query fee quote. Error: %s", err.Error())))
+
+			return
+		}
+
+		var quote types.FeeQuote
+		cliCtx.Codec.MustUnmarshalJSON(res, &quote)
+
+		bz, err := json.Marshal(quote)
+		_, _ = w.Write(bz)
+	}
+}
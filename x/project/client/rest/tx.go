@@ -128,7 +128,7 @@ func updateProjectStatusRequestHandler(cliCtx context.CLIContext) http.HandlerFu
 			EthFundingTxnID: txHash,
 		}
 
-		msg := types.NewUpdateProjectStatusMsg(txHash, senderDid, updateProjectStatusDoc, sovrinDid)
+		msg := types.NewUpdateProjectStatusMsg(txHash, senderDid, updateProjectStatusDoc, sovrinDid.Did)
 		privKey := [64]byte{}
 		copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
 		copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
@@ -204,7 +204,7 @@ func CreateAgentRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			Role:     role,
 		}
 
-		msg := types.NewCreateAgentMsg(txHash, senderDid, createAgentDoc, projectDid)
+		msg := types.NewCreateAgentMsg(txHash, senderDid, createAgentDoc, projectDid.Did)
 
 		privKey := [64]byte{}
 		copy(privKey[:], base58.Decode(projectDid.Secret.SignKey))
@@ -276,7 +276,7 @@ func CreateClaimRequestHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		cliCtx = cliCtx.WithBroadcastMode(mode)
 
-		msg := types.NewCreateClaimMsg(txHash, senderDid, createClaimDoc, sovrinDid)
+		msg := types.NewCreateClaimMsg(txHash, senderDid, createClaimDoc, sovrinDid.Did)
 		privKey := [64]byte{}
 		copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
 		copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
@@ -352,7 +352,7 @@ func CreateEvaluationRequestHandler(cliCtx context.CLIContext) http.HandlerFunc
 			Status:  claimStatus,
 		}
 
-		msg := types.NewCreateEvaluationMsg(txHash, senderDid, createEvaluationDoc, sovrinDid)
+		msg := types.NewCreateEvaluationMsg(txHash, senderDid, createEvaluationDoc, sovrinDid.Did)
 		privKey := [64]byte{}
 		copy(privKey[:], base58.Decode(sovrinDid.Secret.SignKey))
 		copy(privKey[32:], base58.Decode(sovrinDid.VerifyKey))
@@ -14,6 +14,8 @@ func Registercodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(types.CreateClaimMsg{}, "project/CreateClaim", nil)
 	cdc.RegisterConcrete(types.CreateEvaluationMsg{}, "project/CreateEvaluation", nil)
 	cdc.RegisterConcrete(types.WithdrawFundsMsg{}, "project/WithdrawFunds", nil)
+	cdc.RegisterConcrete(types.UpdateWithdrawalStatusMsg{}, "project/UpdateWithdrawalStatus", nil)
+	cdc.RegisterConcrete(types.MsgAttestEthDeposit{}, "project/AttestEthDeposit", nil)
 }
 
 var moduleCdc = codec.New()
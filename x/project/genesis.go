@@ -0,0 +1,23 @@
+package project
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	// A new store already has the current layout, so there is nothing to migrate
+	keeper.SetStoreVersion(ctx, StoreVersion)
+
+	// Initialise the withdrawal queue
+	for _, w := range data.Withdrawals {
+		keeper.SetWithdrawal(ctx, w)
+	}
+	keeper.SetWithdrawalCount(ctx, data.WithdrawalCount)
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return GenesisState{
+		Withdrawals:     k.GetAllWithdrawals(ctx),
+		WithdrawalCount: k.GetWithdrawalCount(ctx),
+	}
+}
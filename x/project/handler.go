@@ -10,28 +10,20 @@ import (
 	"github.com/ixofoundation/ixo-cosmos/x/contracts"
 	"github.com/ixofoundation/ixo-cosmos/x/fees"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
-	"github.com/ixofoundation/ixo-cosmos/x/params"
+	"github.com/ixofoundation/ixo-cosmos/x/node"
 )
 
-type InternalAccountID = string
-
-const (
-	IxoAccountFeesId               InternalAccountID = "IxoFees"
-	IxoAccountPayFeesId            InternalAccountID = "IxoPayFees"
-	InitiatingNodeAccountPayFeesId InternalAccountID = "InitiatingNodePayFees"
-	ValidatingNodeSetAccountFeesId InternalAccountID = "ValidatingNodeSetFees"
-)
-
-func NewHandler(k Keeper, fk fees.Keeper, ck contracts.Keeper, bk bank.Keeper, pk params.Keeper,
-	ethClient ixo.EthClient) sdk.Handler {
+func NewHandler(k Keeper, fk fees.Keeper, ck contracts.Keeper, nk node.Keeper, bk bank.Keeper) sdk.Handler {
 
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 		switch msg := msg.(type) {
 		case CreateProjectMsg:
-			return handleCreateProjectMsg(ctx, k, bk, msg)
+			return handleCreateProjectMsg(ctx, k, nk, bk, msg)
 		case UpdateProjectStatusMsg:
-			return handleUpdateProjectStatusMsg(ctx, k, ck, bk, pk, ethClient, msg)
+			return handleUpdateProjectStatusMsg(ctx, k, ck, bk, msg)
+		case UpdateProjectDocMsg:
+			return handleUpdateProjectDocMsg(ctx, k, msg)
 		case CreateAgentMsg:
 			return handleCreateAgentMsg(ctx, k, bk, msg)
 		case UpdateAgentMsg:
@@ -41,14 +33,23 @@ func NewHandler(k Keeper, fk fees.Keeper, ck contracts.Keeper, bk bank.Keeper, p
 		case CreateEvaluationMsg:
 			return handleCreateEvaluationMsg(ctx, k, fk, bk, msg)
 		case WithdrawFundsMsg:
-			return handleWithdrawFundsMsg(ctx, k, bk, pk, ethClient, msg)
+			return handleWithdrawFundsMsg(ctx, k, bk, msg)
+		case UpdateWithdrawalStatusMsg:
+			return handleUpdateWithdrawalStatusMsg(ctx, k, ck, bk, msg)
+		case MsgAttestEthDeposit:
+			return handleMsgAttestEthDeposit(ctx, k, bk, msg)
 		default:
 			return sdk.ErrUnknownRequest("No match for message type.").Result()
 		}
 	}
 }
 
-func handleCreateProjectMsg(ctx sdk.Context, k Keeper, bk bank.Keeper, msg CreateProjectMsg) sdk.Result {
+func handleCreateProjectMsg(ctx sdk.Context, k Keeper, nk node.Keeper, bk bank.Keeper, msg CreateProjectMsg) sdk.Result {
+
+	// The project must be hosted on a registered cell node
+	if !nk.IsActiveNode(ctx, msg.Data.NodeDid) {
+		return node.ErrNodeNotFound(msg.Data.NodeDid).Result()
+	}
 
 	_, err := createAccountInProjectAccounts(ctx, k, msg.GetProjectDid(), IxoAccountFeesId)
 	if err != nil {
@@ -70,8 +71,8 @@ func handleCreateProjectMsg(ctx sdk.Context, k Keeper, bk bank.Keeper, msg Creat
 	}
 }
 
-func handleUpdateProjectStatusMsg(ctx sdk.Context, k Keeper, ck contracts.Keeper, bk bank.Keeper, pk params.Keeper,
-	ethClient ixo.EthClient, msg UpdateProjectStatusMsg) sdk.Result {
+func handleUpdateProjectStatusMsg(ctx sdk.Context, k Keeper, ck contracts.Keeper, bk bank.Keeper,
+	msg UpdateProjectStatusMsg) sdk.Result {
 
 	ExistingProjectDoc, err := getProjectDoc(ctx, k, msg.GetProjectDid())
 	if err != nil {
@@ -92,14 +93,26 @@ func handleUpdateProjectStatusMsg(ctx sdk.Context, k Keeper, ck contracts.Keeper
 			return sdk.ErrUnknownRequest("Invalid EthFundingTxnID provided").Result()
 		}
 
-		res := fundIfLegitimateEthereumTx(ctx, k, bk, ethClient, ethFundingTxnID, ExistingProjectDoc)
-		if res.Code != sdk.CodeOK {
-			return res
+		// The project is only funded (and its status set to FUNDED) once a
+		// quorum of validators has attested to the funding transaction. A
+		// rejected deposit can be submitted again.
+		projectDid := ExistingProjectDoc.GetProjectDid()
+		if k.IsEthTxApplied(ctx, ethFundingTxnID) {
+			return sdk.ErrUnknownRequest("EthFundingTxnID has already funded a project").Result()
+		} else if deposit, err := k.GetEthDeposit(ctx, projectDid, ethFundingTxnID); err == nil &&
+			deposit.Status != EthDepositRejected {
+			return sdk.ErrUnknownRequest("EthFundingTxnID has already been submitted").Result()
+		}
+
+		k.SetEthDeposit(ctx, NewEthDeposit(ethFundingTxnID, projectDid, ctx.BlockHeight()))
+
+		return sdk.Result{
+			Code: sdk.CodeOK,
 		}
 	}
 
 	if newStatus == PaidoutStatus {
-		res := payoutFees(ctx, k, ck, bk, pk, ethClient, ExistingProjectDoc.GetProjectDid())
+		res := payoutFees(ctx, k, ck, bk, ExistingProjectDoc.GetProjectDid())
 		if res.Code != sdk.CodeOK {
 			return res
 		}
@@ -113,15 +126,9 @@ func handleUpdateProjectStatusMsg(ctx sdk.Context, k Keeper, ck contracts.Keeper
 	}
 }
 
-func payoutFees(ctx sdk.Context, k Keeper, ck contracts.Keeper, bk bank.Keeper, pk params.Keeper,
-	ethClient ixo.EthClient, projectDid ixo.Did) sdk.Result {
+func payoutFees(ctx sdk.Context, k Keeper, ck contracts.Keeper, bk bank.Keeper, projectDid ixo.Did) sdk.Result {
 
-	_, err := ethClient.ProjectWalletFromProjectRegistry(ctx, projectDid)
-	if err != nil {
-		return sdk.ErrUnknownRequest("Could not find Project Ethereum wallet").Result()
-	}
-
-	_, err = payAllFeesToAddress(ctx, k, bk, projectDid, IxoAccountPayFeesId, IxoAccountFeesId)
+	_, err := payAllFeesToAddress(ctx, k, bk, projectDid, IxoAccountPayFeesId, IxoAccountFeesId)
 	if err != nil {
 		return sdk.ErrInternal("Failed to send coins").Result()
 	}
@@ -138,7 +145,7 @@ func payoutFees(ctx sdk.Context, k Keeper, ck contracts.Keeper, bk bank.Keeper,
 
 	ixoEthWallet := ck.GetContract(ctx, contracts.KeyFoundationWallet)
 
-	return payoutERC20AndRecon(ctx, k, bk, pk, ethClient, projectDid, IxoAccountFeesId, ixoEthWallet)
+	return payoutERC20AndRecon(ctx, k, bk, projectDid, IxoAccountFeesId, ixoEthWallet)
 }
 
 func payAllFeesToAddress(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid ixo.Did,
@@ -175,6 +182,17 @@ func getIxoAmount(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDid ixo.Did,
 	return 0
 }
 
+func handleUpdateProjectDocMsg(ctx sdk.Context, k Keeper, msg UpdateProjectDocMsg) sdk.Result {
+	_, err := k.UpdateProjectDocFields(ctx, msg.GetProjectDid(), msg.Data)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Code: sdk.CodeOK,
+	}
+}
+
 func handleCreateAgentMsg(ctx sdk.Context, k Keeper, bk bank.Keeper, msg CreateAgentMsg) sdk.Result {
 	_, err := createAccountInProjectAccounts(ctx, k, msg.GetProjectDid(), msg.Data.AgentDid)
 	if err != nil {
@@ -218,7 +236,7 @@ func handleCreateEvaluationMsg(ctx sdk.Context, k Keeper, fk fees.Keeper, bk ban
 		return sdk.ErrUnknownRequest("Could not find Project").Result()
 	}
 
-	if projectDoc.GetEvaluatorPay() != 0 {
+	if !projectDoc.GetEvaluatorPay().IsZero() {
 		projectDid := msg.GetProjectDid()
 		projectAddr, _ := getAccountInProjectAccounts(ctx, k, projectDid, msg.GetProjectDid())
 		evaluatorAccAddr, _ := getAccountInProjectAccounts(ctx, k, projectDid, msg.GetSenderDid())
@@ -239,28 +257,16 @@ func handleCreateEvaluationMsg(ctx sdk.Context, k Keeper, fk fees.Keeper, bk ban
 			ixoAddr, _ = getAccountInProjectAccounts(ctx, k, msg.GetProjectDid(), IxoAccountPayFeesId)
 		}
 
-		feePercentage := fk.GetDec(ctx, fees.KeyEvaluationPayFeePercentage)
-		nodeFeePercentage := fk.GetDec(ctx, fees.KeyEvaluationPayNodeFeePercentage)
-
-		totalEvaluatorPayAmount := sdk.NewDec(projectDoc.GetEvaluatorPay()).Mul(ixo.IxoDecimals) // This is in IXO * 10^8
-		evaluatorPayFeeAmount := totalEvaluatorPayAmount.Mul(feePercentage)
-		evaluatorPayLessFees := totalEvaluatorPayAmount.Sub(evaluatorPayFeeAmount)
-		nodePayFees := evaluatorPayFeeAmount.Mul(nodeFeePercentage)
-		ixoPayFees := evaluatorPayFeeAmount.Sub(nodePayFees)
-
-		err := bk.SendCoins(ctx, projectAddr, evaluatorAccAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, evaluatorPayLessFees.RoundInt64())})
-		if err != nil {
-			return err.Result()
-		}
-
-		err = bk.SendCoins(ctx, projectAddr, nodeAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, nodePayFees.RoundInt64())})
-		if err != nil {
-			return err.Result()
+		recipients := map[InternalAccountID]sdk.AccAddress{
+			EvaluatorAccountID:             evaluatorAccAddr,
+			InitiatingNodeAccountPayFeesId: nodeAddr,
+			IxoAccountPayFeesId:            ixoAddr,
 		}
-
-		err = bk.SendCoins(ctx, projectAddr, ixoAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, ixoPayFees.RoundInt64())})
-		if err != nil {
-			return err.Result()
+		for _, payment := range k.GetEvaluatorPayPayments(ctx, projectDoc) {
+			err := bk.SendCoins(ctx, projectAddr, recipients[payment.Recipient], sdk.Coins{payment.Amount})
+			if err != nil {
+				return err.Result()
+			}
 		}
 	}
 
@@ -269,8 +275,7 @@ func handleCreateEvaluationMsg(ctx sdk.Context, k Keeper, fk fees.Keeper, bk ban
 	}
 }
 
-func handleWithdrawFundsMsg(ctx sdk.Context, k Keeper, bk bank.Keeper, pk params.Keeper,
-	ethClient ixo.EthClient, msg WithdrawFundsMsg) sdk.Result {
+func handleWithdrawFundsMsg(ctx sdk.Context, k Keeper, bk bank.Keeper, msg WithdrawFundsMsg) sdk.Result {
 
 	withdrawFundsDoc := msg.GetWithdrawFundsDoc()
 	projectDoc, err := getProjectDoc(ctx, k, withdrawFundsDoc.GetProjectDid())
@@ -287,68 +292,143 @@ func handleWithdrawFundsMsg(ctx sdk.Context, k Keeper, bk bank.Keeper, pk params
 
 	var payoutResult sdk.Result
 	if withdrawFundsDoc.IsRefund {
-		payoutResult = payoutERC20AndRecon(ctx, k, bk, pk, ethClient, projectDid, projectDid, ethWalletAddress)
+		payoutResult = payoutERC20AndRecon(ctx, k, bk, projectDid, projectDid, ethWalletAddress)
 	} else {
 		senderDid := msg.GetSenderDid()
-		payoutResult = payoutERC20AndRecon(ctx, k, bk, pk, ethClient, projectDid, senderDid, ethWalletAddress)
+		payoutResult = payoutERC20AndRecon(ctx, k, bk, projectDid, senderDid, ethWalletAddress)
 	}
 
 	return payoutResult
 }
 
-func payoutERC20AndRecon(ctx sdk.Context, k Keeper, bk bank.Keeper, pk params.Keeper, ethClient ixo.EthClient,
+// payoutERC20AndRecon burns the account's balance and queues its transfer to
+// the recipient's Ethereum address. The transfer is performed off-chain by
+// the withdrawal relayer, so that no Ethereum I/O happens during consensus.
+func payoutERC20AndRecon(ctx sdk.Context, k Keeper, bk bank.Keeper,
 	projectDid ixo.Did, accountID string, recipientEthAddress string) sdk.Result {
 
 	balanceToPay := getIxoAmount(ctx, k, bk, projectDid, accountID)
 	if balanceToPay > 0 {
-		projectEthWallet, err := ethClient.ProjectWalletFromProjectRegistry(ctx, projectDid)
-		if err != nil {
-			return sdk.ErrUnknownRequest("Could not find Project Ethereum wallet").Result()
-		}
-
 		account, errRes := getAccountInProjectAccounts(ctx, k, projectDid, accountID)
 		if errRes != nil {
 			return errRes.Result()
 		}
 
-		// TODO: Why is balanceToPay is added to account and removed right after??
-		_, err = bk.AddCoins(ctx, account, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, balanceToPay)})
+		amount := sdk.NewInt64Coin(ixo.IxoNativeToken, balanceToPay)
+		_, err := bk.SubtractCoins(ctx, account, sdk.Coins{amount})
 		if err != nil {
+			return sdk.ErrUnknownRequest("Could not burn tokens from " + account.String()).Result()
 		}
 
-		_, err = bk.SubtractCoins(ctx, account, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, balanceToPay)})
-		if err != nil {
-			return sdk.ErrUnknownRequest("Could not burn tokens from " + account.String()).Result()
+		k.QueueWithdrawal(ctx, projectDid, accountID, recipientEthAddress, amount)
+	}
+
+	return sdk.Result{
+		Code: sdk.CodeOK,
+	}
+}
+
+func handleUpdateWithdrawalStatusMsg(ctx sdk.Context, k Keeper, ck contracts.Keeper, bk bank.Keeper,
+	msg UpdateWithdrawalStatusMsg) sdk.Result {
+
+	relayerDid := ck.GetContract(ctx, contracts.KeyWithdrawalRelayerDid)
+	if relayerDid == "" || msg.GetSenderDid() != relayerDid {
+		return sdk.ErrUnauthorized("Sender is not the withdrawal relayer").Result()
+	}
+
+	doc := msg.GetUpdateWithdrawalStatusDoc()
+	withdrawal, err := k.GetWithdrawal(ctx, doc.WithdrawalID)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !doc.Status.IsValidProgressionFrom(withdrawal.Status) {
+		return sdk.ErrUnknownRequest("Invalid withdrawal status progression requested").Result()
+	}
+
+	switch doc.Status {
+	case WithdrawalSubmitted:
+		withdrawal.ProjectEthWallet = doc.ProjectEthWallet
+		withdrawal.EthTxHash = doc.EthTxHash
+	case WithdrawalConfirmed:
+		addProjectWithdrawalTransaction(ctx, k, withdrawal)
+	case WithdrawalFailed:
+		// Return the burnt tokens to the account that they were withdrawn from
+		account, errRes := getAccountInProjectAccounts(ctx, k, withdrawal.ProjectDid, withdrawal.AccountID)
+		if errRes != nil {
+			return errRes.Result()
 		}
 
-		_, actionID := ethClient.InitiateTokenTransfer(ctx, pk, projectEthWallet, recipientEthAddress, balanceToPay)
+		_, errRes = bk.AddCoins(ctx, account, sdk.Coins{withdrawal.Amount})
+		if errRes != nil {
+			return errRes.Result()
+		}
 
-		addProjectWithdrawalTransaction(ctx, k, projectDid, actionID, projectEthWallet, recipientEthAddress, balanceToPay)
+		withdrawal.FailureReason = doc.FailureReason
 	}
 
+	withdrawal.Status = doc.Status
+	k.SetWithdrawal(ctx, withdrawal)
+
 	return sdk.Result{
 		Code: sdk.CodeOK,
 	}
 }
 
-func fundIfLegitimateEthereumTx(ctx sdk.Context, k Keeper, bk bank.Keeper, ethClient ixo.EthClient,
-	ethFundingTxnID string, ExistingProjectDoc StoredProjectDoc) sdk.Result {
+func handleMsgAttestEthDeposit(ctx sdk.Context, k Keeper, bk bank.Keeper, msg MsgAttestEthDeposit) sdk.Result {
+	if !k.IsValidatorBonded(ctx, msg.Validator) {
+		return sdk.ErrUnauthorized("Sender is not a bonded validator").Result()
+	}
 
-	ethTx, err := ethClient.GetTransactionByHash(ethFundingTxnID)
+	deposit, err := k.GetEthDeposit(ctx, msg.ProjectDid, msg.EthTxHash)
 	if err != nil {
-		return sdk.ErrUnknownRequest("ETH tx not valid: Could not get transaction: " + ethFundingTxnID).Result()
+		return err.Result()
 	}
 
-	isFundingTx := ethClient.IsProjectFundingTx(ctx, ExistingProjectDoc.GetProjectDid(), ethTx)
-	if !isFundingTx {
-		return sdk.ErrUnknownRequest("ETH tx not valid. Not a valid project funding transaction").Result()
+	if deposit.Status != EthDepositPending {
+		return sdk.ErrUnknownRequest("Eth deposit is not pending").Result()
+	} else if deposit.HasAttestationFrom(msg.Validator) {
+		return sdk.ErrUnknownRequest("Validator has already attested to the eth deposit").Result()
+	}
+
+	deposit.Attestations = append(deposit.Attestations, msg.Attestation())
+
+	quorum, reached := k.GetEthDepositQuorum(ctx, deposit)
+	if reached {
+		deposit.ProjectEthWallet = quorum.ProjectEthWallet
+		deposit.Amount = quorum.Amount
+
+		// The attestation is accepted either way, so that a deposit that
+		// cannot be applied is rejected instead of staying pending
+		projectDoc, err := getProjectDoc(ctx, k, deposit.ProjectDid)
+		if err != nil {
+			deposit.Status = EthDepositRejected
+			deposit.RejectionReason = "Could not find Project"
+		} else if !FundedStatus.IsValidProgressionFrom(projectDoc.GetStatus()) {
+			deposit.Status = EthDepositRejected
+			deposit.RejectionReason = fmt.Sprintf("Project cannot be funded in status %s", projectDoc.GetStatus())
+		} else if k.IsEthTxApplied(ctx, deposit.EthTxHash) {
+			deposit.Status = EthDepositRejected
+			deposit.RejectionReason = "Eth transaction has already funded a project"
+		} else {
+			res := fundProject(ctx, k, bk, projectDoc, sdk.NewCoin(ixo.IxoNativeToken, quorum.Amount))
+			if res.Code != sdk.CodeOK {
+				return res
+			}
+
+			projectDoc.SetStatus(FundedStatus)
+			_, _ = k.UpdateProjectDoc(ctx, projectDoc)
+
+			deposit.Status = EthDepositApplied
+			k.SetEthTxApplied(ctx, deposit.EthTxHash)
+		}
 	}
 
-	amt := ethClient.GetFundingAmt(ethTx)
-	fmt.Println("PROJECT_FUNDING", "amt: ", amt)
-	coin := sdk.NewInt64Coin(ixo.IxoNativeToken, amt)
+	k.SetEthDeposit(ctx, deposit)
 
-	return fundProject(ctx, k, bk, ExistingProjectDoc, coin)
+	return sdk.Result{
+		Code: sdk.CodeOK,
+	}
 }
 
 func fundProject(ctx sdk.Context, k Keeper, bk bank.Keeper, projectDoc StoredProjectDoc, coin sdk.Coin) sdk.Result {
@@ -378,47 +458,35 @@ func getProjectDoc(ctx sdk.Context, k Keeper, projectDid ixo.Did) (StoredProject
 func processFees(ctx sdk.Context, k Keeper, fk fees.Keeper, bk bank.Keeper, feeType fees.FeeType, projectDid ixo.Did) (sdk.Result, sdk.Error) {
 
 	projectAddr, _ := getAccountInProjectAccounts(ctx, k, projectDid, projectDid)
-	var validatingNodeSetAddr sdk.AccAddress
-
-	found := checkAccountInProjectAccounts(ctx, k, projectDid, ValidatingNodeSetAccountFeesId)
-	if !found {
-		validatingNodeSetAddr, _ = createAccountInProjectAccounts(ctx, k, projectDid, ValidatingNodeSetAccountFeesId)
-	} else {
-		validatingNodeSetAddr, _ = getAccountInProjectAccounts(ctx, k, projectDid, ValidatingNodeSetAccountFeesId)
-	}
-
-	var ixoAddr sdk.AccAddress
-	found = checkAccountInProjectAccounts(ctx, k, projectDid, IxoAccountFeesId)
-	if !found {
-		ixoAddr, _ = createAccountInProjectAccounts(ctx, k, projectDid, IxoAccountFeesId)
-	} else {
-		ixoAddr, _ = getAccountInProjectAccounts(ctx, k, projectDid, IxoAccountFeesId)
-	}
-
-	ixoFactor := fk.GetDec(ctx, fees.KeyIxoFactor)
-	nodePercentage := fk.GetDec(ctx, fees.KeyNodeFeePercentage)
-	var adjustedFeeAmount sdk.Dec
-
-	switch feeType {
-	case fees.FeeClaimTransaction:
-		adjustedFeeAmount = fk.GetDec(ctx, fees.KeyClaimFeeAmount).Mul(ixoFactor)
-	case fees.FeeEvaluationTransaction:
-		adjustedFeeAmount = fk.GetDec(ctx, fees.KeyEvaluationFeeAmount).Mul(ixoFactor)
-	default:
-		return sdk.Result{}, sdk.ErrUnknownRequest("Invalid Fee type.")
-	}
 
-	nodeAmount := adjustedFeeAmount.Mul(nodePercentage).RoundInt64()
-	ixoAmount := adjustedFeeAmount.RoundInt64() - nodeAmount
-
-	err := bk.SendCoins(ctx, projectAddr, validatingNodeSetAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, nodeAmount)})
+	payments, err := k.GetTransactionFeePayments(ctx, feeType)
 	if err != nil {
 		return sdk.Result{}, err
 	}
 
-	err = bk.SendCoins(ctx, projectAddr, ixoAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, ixoAmount)})
-	if err != nil {
-		return sdk.Result{}, err
+	for _, payment := range payments {
+		amount := sdk.NewCoins(payment.Amount)
+		if amount.IsZero() {
+			continue
+		}
+
+		switch payment.Recipient {
+		case ValidatorsFeeRecipient:
+			err = k.SendFeesToValidators(ctx, projectAddr, amount)
+		case CommunityPoolFeeRecipient:
+			err = k.SendFeesToCommunityPool(ctx, projectAddr, amount)
+		default:
+			var recipientAddr sdk.AccAddress
+			if !checkAccountInProjectAccounts(ctx, k, projectDid, payment.Recipient) {
+				recipientAddr, _ = createAccountInProjectAccounts(ctx, k, projectDid, payment.Recipient)
+			} else {
+				recipientAddr, _ = getAccountInProjectAccounts(ctx, k, projectDid, payment.Recipient)
+			}
+			err = bk.SendCoins(ctx, projectAddr, recipientAddr, amount)
+		}
+		if err != nil {
+			return sdk.Result{}, err
+		}
 	}
 
 	return sdk.Result{
@@ -433,17 +501,17 @@ func checkAccountInProjectAccounts(ctx sdk.Context, k Keeper, projectDid ixo.Did
 	return found
 }
 
-func addProjectWithdrawalTransaction(ctx sdk.Context, k Keeper, projectDid ixo.Did, actionID [32]byte, projectEthWallet string, recipientEthAddress string, amount int64) {
-	actionIDStr := "0x" + hex.EncodeToString(actionID[:])
+func addProjectWithdrawalTransaction(ctx sdk.Context, k Keeper, withdrawal Withdrawal) {
+	actionID := withdrawal.ActionID()
 
 	withdrawalInfo := WithdrawalInfo{
-		actionIDStr,
-		projectEthWallet,
-		recipientEthAddress,
-		amount,
+		ActionID:            "0x" + hex.EncodeToString(actionID[:]),
+		ProjectEthWallet:    withdrawal.ProjectEthWallet,
+		RecipientEthAddress: withdrawal.RecipientEthAddress,
+		Amount:              withdrawal.Amount.Amount.Int64(),
 	}
 
-	k.AddProjectWithdrawalTransaction(ctx, projectDid, withdrawalInfo)
+	k.AddProjectWithdrawalTransaction(ctx, withdrawal.ProjectDid, withdrawalInfo)
 }
 
 func createAccountInProjectAccounts(ctx sdk.Context, k Keeper, projectDid ixo.Did, accountId string) (sdk.AccAddress, sdk.Error) {
@@ -468,7 +536,10 @@ func getAccountInProjectAccounts(ctx sdk.Context, k Keeper, projectDid ixo.Did,
 	accountIDAddrInterface, found := accMap[accountId]
 	if found {
 		accountIDAccAddr = accountIDAddrInterface.(string)
-		addr := sdk.AccAddress([]byte(accountIDAccAddr))
+		addr, err := sdk.AccAddressFromBech32(accountIDAccAddr)
+		if err != nil {
+			return nil, sdk.ErrInvalidAddress(err.Error())
+		}
 		return addr, nil
 	} else {
 		return createAccountInProjectAccounts(ctx, k, projectDid, accountId)
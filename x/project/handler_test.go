@@ -2,7 +2,9 @@ package project
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,13 +15,14 @@ import (
 	"github.com/ixofoundation/ixo-cosmos/x/contracts"
 	"github.com/ixofoundation/ixo-cosmos/x/fees"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/node"
 	"github.com/ixofoundation/ixo-cosmos/x/project/internal/keeper"
 	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
 )
 
 func TestHandler_CreateClaim(t *testing.T) {
 
-	ctx, keeper, cdc, feesKeeper, bankKeeper, _ := keeper.CreateTestInput()
+	ctx, keeper, cdc, feesKeeper, bankKeeper, _, _, _ := keeper.CreateTestInput()
 	codec.RegisterCrypto(cdc)
 	cdc.RegisterConcrete(types.CreateProjectMsg{}, "ixo/createProjectMsg", nil)
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
@@ -40,24 +43,32 @@ func TestHandler_CreateClaim(t *testing.T) {
 }
 
 func TestHandler_ProjectMsg(t *testing.T) {
-	ctx, keeper, cdc, _, bankKeeper, _ := keeper.CreateTestInput()
+	ctx, keeper, cdc, _, bankKeeper, _, _, nodeKeeper := keeper.CreateTestInput()
 	codec.RegisterCrypto(cdc)
 	cdc.RegisterConcrete(types.CreateProjectMsg{}, "ixo/createProjectMsg", nil)
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
 
-	res := handleCreateProjectMsg(ctx, keeper, bankKeeper, types.ValidCreateProjectMsg)
+	// Projects can only be created on a registered, active node
+	res := handleCreateProjectMsg(ctx, keeper, nodeKeeper, bankKeeper, types.ValidCreateProjectMsg)
+	require.False(t, res.IsOK())
+
+	nodeOperator := sdk.AccAddress([]byte("nodeOperator"))
+	nodeKeeper.SetNode(ctx, node.NewNode(types.ValidCreateProjectMsg.Data.NodeDid,
+		"https://cellnode.ixo.world", nodeOperator, sdk.NewCoins(), ctx.BlockHeight()))
+
+	res = handleCreateProjectMsg(ctx, keeper, nodeKeeper, bankKeeper, types.ValidCreateProjectMsg)
 
 	var projectDoc CreateProjectMsg
 	json.Unmarshal(res.Data, &projectDoc)
 	require.True(t, res.IsOK())
 
-	res = handleCreateProjectMsg(ctx, keeper, bankKeeper, types.ValidCreateProjectMsg)
+	res = handleCreateProjectMsg(ctx, keeper, nodeKeeper, bankKeeper, types.ValidCreateProjectMsg)
 	require.False(t, res.IsOK())
 
 }
 func Test_CreateEvaluation(t *testing.T) {
-	ctx, k, cdc, fk, bk, _ := keeper.CreateTestInput()
+	ctx, k, cdc, fk, bk, _, _, _ := keeper.CreateTestInput()
 
 	codec.RegisterCrypto(cdc)
 	cdc.RegisterConcrete(types.CreateEvaluationMsg{}, "ixo/createEvaluationMsg", nil)
@@ -90,10 +101,10 @@ func Test_CreateEvaluation(t *testing.T) {
 		PubKey:     "47mm6LCDAyJmqkbUbqGoZKZkBixjBgvDFRMwQRF9HWMU",
 		Data: types.ProjectDoc{
 			NodeDid:              "Tu2QWRHuDufywDALbBQ2r",
-			RequiredClaims:       "requireClaims1",
-			EvaluatorPayPerClaim: "10",
+			RequiredClaims:       1,
+			EvaluatorPayPerClaim: sdk.NewInt64Coin(ixo.IxoNativeToken, 1000000000),
 			ServiceEndpoint:      "https://togo.pds.ixo.network",
-			CreatedOn:            "2018-05-21T15:53:18.484Z",
+			CreatedOn:            time.Date(2018, 5, 21, 15, 53, 18, 484000000, time.UTC),
 			CreatedBy:            "6Fu7FbbGoCJ8tX3vMMCss9",
 			Status:               "CREATED",
 		},
@@ -110,7 +121,7 @@ func Test_CreateEvaluation(t *testing.T) {
 }
 
 func Test_WithdrawFunds(t *testing.T) {
-	ctx, k, cdc, _, bk, pk := keeper.CreateTestInput()
+	ctx, k, cdc, _, bk, _, _, _ := keeper.CreateTestInput()
 	codec.RegisterCrypto(cdc)
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
@@ -135,28 +146,273 @@ func Test_WithdrawFunds(t *testing.T) {
 		PubKey:     "47mm6LCDAyJmqkbUbqGoZKZkBixjBgvDFRMwQRF9HWMU",
 		Data: types.ProjectDoc{
 			NodeDid:              "Tu2QWRHuDufywDALbBQ2r",
-			RequiredClaims:       "requireClaims1",
-			EvaluatorPayPerClaim: "10",
+			RequiredClaims:       1,
+			EvaluatorPayPerClaim: sdk.NewInt64Coin(ixo.IxoNativeToken, 1000000000),
 			ServiceEndpoint:      "https://togo.pds.ixo.network",
-			CreatedOn:            "2018-05-21T15:53:18.484Z",
+			CreatedOn:            time.Date(2018, 5, 21, 15, 53, 18, 484000000, time.UTC),
 			CreatedBy:            "6Fu7FbbGoCJ8tX3vMMCss9",
 			Status:               "PAIDOUT",
 		},
 	}
 	createAccountInProjectAccounts(ctx, k, msg1.GetProjectDid(), IxoAccountFeesId)
-	createAccountInProjectAccounts(ctx, k, msg1.GetProjectDid(), msg1.GetProjectDid())
+	projectAddr, _ := createAccountInProjectAccounts(ctx, k, msg1.GetProjectDid(), msg1.GetProjectDid())
+
+	err := k.SetProjectDoc(ctx, &msg1)
+	require.Nil(t, err)
+
+	_, err = bk.AddCoins(ctx, projectAddr, sdk.Coins{sdk.NewInt64Coin(ixo.IxoNativeToken, 100)})
+	require.Nil(t, err)
+
+	res := handleWithdrawFundsMsg(ctx, k, bk, msg)
+	require.True(t, res.IsOK())
+
+	// Balance is burnt and the transfer is queued for the relayer
+	require.True(t, bk.GetCoins(ctx, projectAddr).IsZero())
+	withdrawals := k.GetWithdrawalsByStatus(ctx, WithdrawalPending)
+	require.Len(t, withdrawals, 1)
+	require.Equal(t, msg1.GetProjectDid(), withdrawals[0].ProjectDid)
+	require.Equal(t, "ethwallet", withdrawals[0].RecipientEthAddress)
+	require.Equal(t, sdk.NewInt64Coin(ixo.IxoNativeToken, 100), withdrawals[0].Amount)
+}
+
+func Test_UpdateWithdrawalStatus(t *testing.T) {
+	ctx, k, cdc, _, bk, pk, _, _ := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+
+	projectDid := "6iftm1hHdaU6LJGKayRMev"
+	relayerDid := "Tu2QWRHuDufywDALbBQ2r"
+	projectAddr, _ := createAccountInProjectAccounts(ctx, k, projectDid, projectDid)
 
 	ck := contracts.NewKeeper(cdc, pk)
-	ck.SetContract(ctx, contracts.KeyProjectRegistryContractAddress, "foundationWallet")
+	ck.SetContract(ctx, contracts.KeyWithdrawalRelayerDid, relayerDid)
 
-	err := k.SetProjectDoc(ctx, &msg1)
+	amount := sdk.NewInt64Coin(ixo.IxoNativeToken, 100)
+	first := k.QueueWithdrawal(ctx, projectDid, projectDid, "ethwallet", amount)
+	second := k.QueueWithdrawal(ctx, projectDid, projectDid, "ethwallet", amount)
+
+	newMsg := func(senderDid string, id uint64, status WithdrawalStatus) UpdateWithdrawalStatusMsg {
+		return types.NewUpdateWithdrawalStatusMsg(senderDid, types.UpdateWithdrawalStatusDoc{
+			WithdrawalID:     id,
+			Status:           status,
+			ProjectEthWallet: "projectEthWallet",
+			EthTxHash:        "ethTxHash",
+			FailureReason:    "failureReason",
+		})
+	}
+
+	// Only the relayer can update the status
+	res := handleUpdateWithdrawalStatusMsg(ctx, k, ck, bk, newMsg(projectDid, first.ID, WithdrawalSubmitted))
+	require.False(t, res.IsOK())
+
+	// Cannot be confirmed before being submitted
+	res = handleUpdateWithdrawalStatusMsg(ctx, k, ck, bk, newMsg(relayerDid, first.ID, WithdrawalConfirmed))
+	require.False(t, res.IsOK())
+
+	res = handleUpdateWithdrawalStatusMsg(ctx, k, ck, bk, newMsg(relayerDid, first.ID, WithdrawalSubmitted))
+	require.True(t, res.IsOK())
+	res = handleUpdateWithdrawalStatusMsg(ctx, k, ck, bk, newMsg(relayerDid, first.ID, WithdrawalConfirmed))
+	require.True(t, res.IsOK())
+
+	confirmed, err := k.GetWithdrawal(ctx, first.ID)
+	require.Nil(t, err)
+	require.Equal(t, WithdrawalConfirmed, confirmed.Status)
+	require.Equal(t, "ethTxHash", confirmed.EthTxHash)
+	txs, err := k.GetProjectWithdrawalTransactions(ctx, projectDid)
 	require.Nil(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, "projectEthWallet", txs[0].ProjectEthWallet)
 
-	cK := contracts.NewKeeper(cdc, pk)
-	ethClient, err1 := ixo.NewEthClient(cK)
-	require.Nil(t, err1)
-	require.NotNil(t, ethClient)
+	// A failed withdrawal returns the tokens to the account
+	res = handleUpdateWithdrawalStatusMsg(ctx, k, ck, bk, newMsg(relayerDid, second.ID, WithdrawalFailed))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.Coins{amount}, bk.GetCoins(ctx, projectAddr))
 
-	res := handleWithdrawFundsMsg(ctx, k, bk, pk, ethClient, msg)
-	require.NotNil(t, res)
+	require.Len(t, k.GetWithdrawalsByStatus(ctx, WithdrawalPending), 0)
+	require.Len(t, k.GetWithdrawalsByStatus(ctx, WithdrawalConfirmed), 1)
+	require.Len(t, k.GetWithdrawalsByStatus(ctx, WithdrawalFailed), 1)
+}
+
+func Test_AttestEthDeposit(t *testing.T) {
+	ctx, k, cdc, _, bk, pk, sk, _ := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+	ck := contracts.NewKeeper(cdc, pk)
+
+	projectMsg := types.ValidUpdateProjectMsg
+	projectDid := projectMsg.GetProjectDid()
+	projectAddr, _ := createAccountInProjectAccounts(ctx, k, projectDid, projectDid)
+	k.AddProjectDoc(ctx, &projectMsg)
+
+	// Four validators with equal power
+	var validators []sdk.ValAddress
+	for i := 0; i < 4; i++ {
+		validator := sdk.ValAddress([]byte(fmt.Sprintf("validator%d", i)))
+		sk.SetLastValidatorPower(ctx, validator, 10)
+		validators = append(validators, validator)
+	}
+	sk.SetLastTotalPower(ctx, sdk.NewInt(40))
+
+	ethTxHash := "0xethTxHash"
+	amount := sdk.NewInt(1000)
+	ethereum := types.FakeEthDepositSource{
+		ethTxHash: {ProjectDid: projectDid, ProjectEthWallet: "0xprojectWallet", Amount: amount},
+	}
+	faultyEthereum := types.FakeEthDepositSource{
+		ethTxHash: {ProjectDid: projectDid, ProjectEthWallet: "0xprojectWallet", Amount: amount.AddRaw(1)},
+	}
+
+	attest := func(source types.EthDepositSource, validator sdk.ValAddress) sdk.Result {
+		wallet, amount, err := source.GetProjectFunding(ethTxHash, projectDid)
+		require.Nil(t, err)
+		msg := types.NewMsgAttestEthDeposit(validator, projectDid, ethTxHash, wallet, amount)
+		require.Nil(t, msg.ValidateBasic())
+		return handleMsgAttestEthDeposit(ctx, k, bk, msg)
+	}
+
+	// Cannot attest before the project submits the funding transaction
+	require.False(t, attest(ethereum, validators[0]).IsOK())
+
+	// Another project submitting the transaction first does not block it
+	otherMsg := types.ValidUpdateProjectMsg
+	otherMsg.ProjectDid = "did:ixo:otherProject"
+	k.AddProjectDoc(ctx, &otherMsg)
+	res := handleUpdateProjectStatusMsg(ctx, k, ck, bk, types.UpdateProjectStatusMsg{
+		ProjectDid: otherMsg.ProjectDid,
+		Data: types.UpdateProjectStatusDoc{
+			Status:          types.FundedStatus,
+			EthFundingTxnID: ethTxHash,
+		},
+	})
+	require.True(t, res.IsOK())
+
+	statusMsg := types.UpdateProjectStatusMsg{
+		ProjectDid: projectDid,
+		Data: types.UpdateProjectStatusDoc{
+			Status:          types.FundedStatus,
+			EthFundingTxnID: ethTxHash,
+		},
+	}
+	res = handleUpdateProjectStatusMsg(ctx, k, ck, bk, statusMsg)
+	require.True(t, res.IsOK())
+	res = handleUpdateProjectStatusMsg(ctx, k, ck, bk, statusMsg)
+	require.False(t, res.IsOK())
+
+	// Only bonded validators can attest, and only once
+	require.False(t, attest(ethereum, sdk.ValAddress([]byte("notValidator"))).IsOK())
+	require.True(t, attest(ethereum, validators[0]).IsOK())
+	require.False(t, attest(ethereum, validators[0]).IsOK())
+
+	// 2/4 honest and 1/4 faulty attestations are not a quorum
+	require.True(t, attest(ethereum, validators[1]).IsOK())
+	require.True(t, attest(faultyEthereum, validators[2]).IsOK())
+	projectDoc, _ := getProjectDoc(ctx, k, projectDid)
+	require.Equal(t, types.PendingStatus, projectDoc.GetStatus())
+	require.True(t, bk.GetCoins(ctx, projectAddr).IsZero())
+
+	// 3/4 honest attestations are, so the project is funded
+	require.True(t, attest(ethereum, validators[3]).IsOK())
+	projectDoc, _ = getProjectDoc(ctx, k, projectDid)
+	require.Equal(t, types.FundedStatus, projectDoc.GetStatus())
+	require.Equal(t, amount, bk.GetCoins(ctx, projectAddr).AmountOf(ixo.IxoNativeToken))
+
+	deposit, err := k.GetEthDeposit(ctx, projectDid, ethTxHash)
+	require.Nil(t, err)
+	require.Equal(t, types.EthDepositApplied, deposit.Status)
+	require.Equal(t, "0xprojectWallet", deposit.ProjectEthWallet)
+	require.Equal(t, amount, deposit.Amount)
+	require.Len(t, k.GetPendingEthDeposits(ctx), 1)
+
+	// The transaction cannot be submitted again to fund another project
+	res = handleUpdateProjectStatusMsg(ctx, k, ck, bk, types.UpdateProjectStatusMsg{
+		ProjectDid: otherMsg.ProjectDid,
+		Data: types.UpdateProjectStatusDoc{
+			Status:          types.FundedStatus,
+			EthFundingTxnID: ethTxHash,
+		},
+	})
+	require.False(t, res.IsOK())
+}
+
+func Test_AttestEthDepositRejected(t *testing.T) {
+	ctx, k, cdc, _, bk, pk, sk, _ := keeper.CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+	ck := contracts.NewKeeper(cdc, pk)
+
+	projectMsg := types.ValidUpdateProjectMsg
+	projectDid := projectMsg.GetProjectDid()
+	projectAddr, _ := createAccountInProjectAccounts(ctx, k, projectDid, projectDid)
+	k.AddProjectDoc(ctx, &projectMsg)
+
+	validator := sdk.ValAddress([]byte("validator"))
+	sk.SetLastValidatorPower(ctx, validator, 10)
+	sk.SetLastTotalPower(ctx, sdk.NewInt(10))
+
+	ethTxHash := "0xethTxHash"
+	statusMsg := types.UpdateProjectStatusMsg{
+		ProjectDid: projectDid,
+		Data: types.UpdateProjectStatusDoc{
+			Status:          types.FundedStatus,
+			EthFundingTxnID: ethTxHash,
+		},
+	}
+	require.True(t, handleUpdateProjectStatusMsg(ctx, k, ck, bk, statusMsg).IsOK())
+
+	// The project can no longer be funded when the quorum is reached
+	projectMsg.SetStatus(types.StartedStatus)
+	k.AddProjectDoc(ctx, &projectMsg)
+
+	amount := sdk.NewInt(1000)
+	msg := types.NewMsgAttestEthDeposit(validator, projectDid, ethTxHash, "0xprojectWallet", amount)
+	require.True(t, handleMsgAttestEthDeposit(ctx, k, bk, msg).IsOK())
+	require.True(t, bk.GetCoins(ctx, projectAddr).IsZero())
+
+	deposit, err := k.GetEthDeposit(ctx, projectDid, ethTxHash)
+	require.Nil(t, err)
+	require.Equal(t, types.EthDepositRejected, deposit.Status)
+	require.NotEmpty(t, deposit.RejectionReason)
+	require.Len(t, k.GetPendingEthDeposits(ctx), 0)
+
+	// A rejected deposit can be submitted again
+	projectMsg.SetStatus(types.PendingStatus)
+	k.AddProjectDoc(ctx, &projectMsg)
+	require.True(t, handleUpdateProjectStatusMsg(ctx, k, ck, bk, statusMsg).IsOK())
+	require.True(t, handleMsgAttestEthDeposit(ctx, k, bk, msg).IsOK())
+	require.Equal(t, amount, bk.GetCoins(ctx, projectAddr).AmountOf(ixo.IxoNativeToken))
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := keeper.CreateTestInput()
+
+	pending := k.QueueWithdrawal(ctx, "6iftm1hHdaU6LJGKayRMev", "account1",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", sdk.NewInt64Coin(ixo.IxoNativeToken, 10))
+	confirmed := k.QueueWithdrawal(ctx, "6iftm1hHdaU6LJGKayRMev", "account1",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", sdk.NewInt64Coin(ixo.IxoNativeToken, 20))
+	confirmed.Status = types.WithdrawalConfirmed
+	k.SetWithdrawal(ctx, confirmed)
+
+	genesis := ExportGenesis(ctx, k)
+	require.Equal(t, []types.Withdrawal{pending, confirmed}, genesis.Withdrawals)
+	require.Equal(t, uint64(2), genesis.WithdrawalCount)
+	require.Nil(t, ValidateGenesis(genesis))
+
+	// The imported queue keeps its statuses and the next ID follows on
+	ctx, k, _, _, _, _, _, _ = keeper.CreateTestInput()
+	InitGenesis(ctx, k, genesis)
+	require.Equal(t, StoreVersion, k.GetStoreVersion(ctx))
+	require.Equal(t, []types.Withdrawal{pending}, k.GetWithdrawalsByStatus(ctx, types.WithdrawalPending))
+	require.Equal(t, []types.Withdrawal{confirmed}, k.GetWithdrawalsByStatus(ctx, types.WithdrawalConfirmed))
+	require.Equal(t, uint64(2), k.QueueWithdrawal(ctx, "6iftm1hHdaU6LJGKayRMev", "account1",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", sdk.NewInt64Coin(ixo.IxoNativeToken, 30)).ID)
+
+	// IDs must be unique and below the withdrawal count
+	genesis.WithdrawalCount = 1
+	require.NotNil(t, ValidateGenesis(genesis))
+	genesis.WithdrawalCount = 2
+	genesis.Withdrawals[1].ID = 0
+	require.NotNil(t, ValidateGenesis(genesis))
 }
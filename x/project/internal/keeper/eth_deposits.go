@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+func (k Keeper) GetEthDeposit(ctx sdk.Context, projectDid ixo.Did, ethTxHash string) (types.EthDeposit, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEthDepositKey(projectDid, ethTxHash))
+	if bz == nil {
+		return types.EthDeposit{}, sdk.ErrUnknownRequest(fmt.Sprintf(
+			"Eth deposit %s of project %s does not exist", ethTxHash, projectDid))
+	}
+
+	var deposit types.EthDeposit
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &deposit)
+
+	return deposit, nil
+}
+
+func (k Keeper) EthDepositExists(ctx sdk.Context, projectDid ixo.Did, ethTxHash string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetEthDepositKey(projectDid, ethTxHash))
+}
+
+func (k Keeper) SetEthDeposit(ctx sdk.Context, deposit types.EthDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEthDepositKey(deposit.ProjectDid, deposit.EthTxHash), k.cdc.MustMarshalBinaryLengthPrefixed(deposit))
+}
+
+// IsEthTxApplied returns whether the transfer has funded a project already,
+// so that the same transfer cannot fund a second project
+func (k Keeper) IsEthTxApplied(ctx sdk.Context, ethTxHash string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAppliedEthTxKey(ethTxHash))
+}
+
+func (k Keeper) SetEthTxApplied(ctx sdk.Context, ethTxHash string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAppliedEthTxKey(ethTxHash), []byte{1})
+}
+
+func (k Keeper) GetAllEthDeposits(ctx sdk.Context) (deposits []types.EthDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EthDepositKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.EthDeposit
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+func (k Keeper) GetPendingEthDeposits(ctx sdk.Context) (deposits []types.EthDeposit) {
+	for _, deposit := range k.GetAllEthDeposits(ctx) {
+		if deposit.Status == types.EthDepositPending {
+			deposits = append(deposits, deposit)
+		}
+	}
+	return deposits
+}
+
+// GetEthDepositQuorum returns the attestation backed by more than 2/3 of the
+// total voting power of the last validator set, if there is one. Only the
+// attestations of validators that are still in the set carry any power.
+func (k Keeper) GetEthDepositQuorum(ctx sdk.Context, deposit types.EthDeposit) (types.EthDepositAttestation, bool) {
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return types.EthDepositAttestation{}, false
+	}
+
+	for i, attestation := range deposit.Attestations {
+		power := sdk.ZeroInt()
+		for _, other := range deposit.Attestations[i:] {
+			if attestation.Agrees(other) {
+				power = power.AddRaw(k.stakingKeeper.GetLastValidatorPower(ctx, other.Validator))
+			}
+		}
+
+		if power.MulRaw(3).GT(totalPower.MulRaw(2)) {
+			return attestation, true
+		}
+	}
+
+	return types.EthDepositAttestation{}, false
+}
+
+func (k Keeper) IsValidatorBonded(ctx sdk.Context, validator sdk.ValAddress) bool {
+	return k.stakingKeeper.GetLastValidatorPower(ctx, validator) > 0
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+
+	"github.com/ixofoundation/ixo-cosmos/x/fees"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+// GetTransactionFeePayments splits the fee charged for a claim or evaluation
+// between the validators and ixo. The ixo share goes to the community pool if
+// the IxoFeesToCommunityPool fee param is set.
+func (k Keeper) GetTransactionFeePayments(ctx sdk.Context, feeType fees.FeeType) ([]types.FeePayment, sdk.Error) {
+	ixoFactor := k.feeKeeper.GetDec(ctx, fees.KeyIxoFactor)
+	nodePercentage := k.feeKeeper.GetDec(ctx, fees.KeyNodeFeePercentage)
+	var adjustedFeeAmount sdk.Dec
+
+	switch feeType {
+	case fees.FeeClaimTransaction:
+		adjustedFeeAmount = k.feeKeeper.GetDec(ctx, fees.KeyClaimFeeAmount).Mul(ixoFactor)
+	case fees.FeeEvaluationTransaction:
+		adjustedFeeAmount = k.feeKeeper.GetDec(ctx, fees.KeyEvaluationFeeAmount).Mul(ixoFactor)
+	default:
+		return nil, sdk.ErrUnknownRequest("Invalid Fee type.")
+	}
+
+	nodeAmount := adjustedFeeAmount.Mul(nodePercentage).RoundInt64()
+	ixoAmount := adjustedFeeAmount.RoundInt64() - nodeAmount
+
+	ixoRecipient := types.IxoAccountFeesId
+	if k.feeKeeper.IxoFeesToCommunityPool(ctx) {
+		ixoRecipient = types.CommunityPoolFeeRecipient
+	}
+
+	return []types.FeePayment{
+		types.NewFeePayment(types.ValidatorsFeeRecipient, nodeAmount),
+		types.NewFeePayment(ixoRecipient, ixoAmount),
+	}, nil
+}
+
+// GetEvaluatorPayPayments splits the project's pay per evaluation between the
+// evaluator, the initiating node and ixo
+func (k Keeper) GetEvaluatorPayPayments(ctx sdk.Context, projectDoc types.StoredProjectDoc) []types.FeePayment {
+	evaluatorPay := projectDoc.GetEvaluatorPay()
+	if evaluatorPay.IsZero() {
+		return nil
+	}
+
+	feePercentage := k.feeKeeper.GetDec(ctx, fees.KeyEvaluationPayFeePercentage)
+	nodeFeePercentage := k.feeKeeper.GetDec(ctx, fees.KeyEvaluationPayNodeFeePercentage)
+
+	totalEvaluatorPayAmount := evaluatorPay.Amount.ToDec()
+	evaluatorPayFeeAmount := totalEvaluatorPayAmount.Mul(feePercentage)
+	evaluatorPayLessFees := totalEvaluatorPayAmount.Sub(evaluatorPayFeeAmount)
+	nodePayFees := evaluatorPayFeeAmount.Mul(nodeFeePercentage)
+	ixoPayFees := evaluatorPayFeeAmount.Sub(nodePayFees)
+
+	return []types.FeePayment{
+		types.NewFeePayment(types.EvaluatorAccountID, evaluatorPayLessFees.RoundInt64()),
+		types.NewFeePayment(types.InitiatingNodeAccountPayFeesId, nodePayFees.RoundInt64()),
+		types.NewFeePayment(types.IxoAccountPayFeesId, ixoPayFees.RoundInt64()),
+	}
+}
+
+// GetFeeQuote returns the payments that processing a message of the given
+// type would make out of the project account, at the current fees
+func (k Keeper) GetFeeQuote(ctx sdk.Context, projectDid ixo.Did, msgType types.FeeQuoteMsgType) (types.FeeQuote, sdk.Error) {
+	projectDoc, err := k.GetProjectDoc(ctx, projectDid)
+	if err != nil {
+		return types.FeeQuote{}, err
+	}
+
+	payments := []types.FeePayment{}
+	switch msgType {
+	case types.FeeQuoteClaim:
+		claimPayments, err := k.GetTransactionFeePayments(ctx, fees.FeeClaimTransaction)
+		if err != nil {
+			return types.FeeQuote{}, err
+		}
+		payments = append(payments, claimPayments...)
+	case types.FeeQuoteEvaluation:
+		evaluationPayments, err := k.GetTransactionFeePayments(ctx, fees.FeeEvaluationTransaction)
+		if err != nil {
+			return types.FeeQuote{}, err
+		}
+		payments = append(payments, evaluationPayments...)
+		payments = append(payments, k.GetEvaluatorPayPayments(ctx, projectDoc)...)
+	case types.FeeQuoteAgentRegistration:
+		// Agent registration is not charged any fees
+	default:
+		return types.FeeQuote{}, sdk.ErrUnknownRequest(fmt.Sprintf("invalid fee quote msg type %s", msgType))
+	}
+
+	return types.NewFeeQuote(projectDid, msgType, payments), nil
+}
+
+// SendFeesToValidators sends the fees to the fee collector, from which the
+// distribution module pays them out to validators and delegators by stake
+func (k Keeper) SendFeesToValidators(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, from, auth.FeeCollectorName, amount)
+}
+
+// SendFeesToCommunityPool sends the fees to the distribution module account
+// and adds them to the community pool
+func (k Keeper) SendFeesToCommunityPool(ctx sdk.Context, from sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, from, distribution.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	feePool := k.distributionKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(amount))
+	k.distributionKeeper.SetFeePool(ctx, feePool)
+
+	return nil
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	didTypes "github.com/ixofoundation/ixo-cosmos/x/did"
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
@@ -16,18 +19,25 @@ import (
 )
 
 type Keeper struct {
-	cdc           *codec.Codec
-	storeKey      sdk.StoreKey
-	accountKeeper auth.AccountKeeper
-	feeKeeper     fees.Keeper
+	cdc                *codec.Codec
+	storeKey           sdk.StoreKey
+	accountKeeper      auth.AccountKeeper
+	feeKeeper          fees.Keeper
+	stakingKeeper      staking.Keeper
+	supplyKeeper       supply.Keeper
+	distributionKeeper distribution.Keeper
 }
 
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, accountKeeper auth.AccountKeeper, feeKeeper fees.Keeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, accountKeeper auth.AccountKeeper, feeKeeper fees.Keeper,
+	stakingKeeper staking.Keeper, supplyKeeper supply.Keeper, distributionKeeper distribution.Keeper) Keeper {
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      key,
-		accountKeeper: accountKeeper,
-		feeKeeper:     feeKeeper,
+		cdc:                cdc,
+		storeKey:           key,
+		accountKeeper:      accountKeeper,
+		feeKeeper:          feeKeeper,
+		stakingKeeper:      stakingKeeper,
+		supplyKeeper:       supplyKeeper,
+		distributionKeeper: distributionKeeper,
 	}
 }
 
@@ -57,7 +67,21 @@ func (k Keeper) SetProjectDoc(ctx sdk.Context, projectDoc types.StoredProjectDoc
 	return nil
 }
 
+// AddProjectDoc stores the project doc and moves it to the status, creator
+// and node indexes of its current fields
 func (k Keeper) AddProjectDoc(ctx sdk.Context, projectDoc types.StoredProjectDoc) {
+	existing, err := k.GetProjectDoc(ctx, projectDoc.GetProjectDid())
+	if err == nil {
+		k.removeProjectDocIndexes(ctx, existing)
+	} else {
+		k.incrementProjectCount(ctx, types.ProjectKey)
+	}
+
+	k.setProjectDoc(ctx, projectDoc)
+	k.addProjectDocIndexes(ctx, projectDoc)
+}
+
+func (k Keeper) setProjectDoc(ctx sdk.Context, projectDoc types.StoredProjectDoc) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetProjectPrefixKey(projectDoc.GetProjectDid())
 	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(projectDoc))
@@ -101,9 +125,13 @@ func (k Keeper) AddAccountToProjectAccounts(ctx sdk.Context, projectDid ixo.Did,
 		return
 	}
 
+	accountMap[accountId] = account.GetAddress().String()
+	k.setAccountMap(ctx, projectDid, accountMap)
+}
+
+func (k Keeper) setAccountMap(ctx sdk.Context, projectDid ixo.Did, accountMap map[string]interface{}) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetAccountPrefixKey(projectDid)
-	accountMap[accountId] = string(account.GetAddress().Bytes())
 
 	bz, err := json.Marshal(accountMap)
 	if err != nil {
@@ -113,17 +141,42 @@ func (k Keeper) AddAccountToProjectAccounts(ctx sdk.Context, projectDid ixo.Did,
 	store.Set(key, bz)
 }
 
-func (k Keeper) CreateNewAccount(ctx sdk.Context, projectDid ixo.Did, accountId string) (auth.Account, sdk.Error) {
-	src := []byte(projectDid + "/" + accountId)
-	hexAddress := hex.EncodeToString(src)
-	address := sdk.AccAddress(hexAddress)
+// GetMigratedAccount returns where the project account that used to be at
+// the old hex-encoded address has been moved to
+func (k Keeper) GetMigratedAccount(ctx sdk.Context, oldAddress sdk.AccAddress) (types.MigratedAccount, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
 
-	if k.accountKeeper.GetAccount(ctx, address) != nil {
+	bz := store.Get(types.GetMigratedAccountKey(oldAddress))
+	if bz == nil {
+		return types.MigratedAccount{}, sdk.ErrUnknownAddress(
+			fmt.Sprintf("no project account was migrated from %s", hex.EncodeToString(oldAddress)))
+	}
+
+	return types.MigratedAccount{
+		OldAddress: hex.EncodeToString(oldAddress),
+		NewAddress: sdk.AccAddress(bz),
+	}, nil
+}
+
+func (k Keeper) setMigratedAccount(ctx sdk.Context, oldAddress sdk.AccAddress, newAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMigratedAccountKey(oldAddress), newAddress.Bytes())
+}
+
+// CreateNewAccount creates the project account with the given id. Its address
+// is derived from the project DID, so the account may already exist if coins
+// were sent to that address before the project account was created.
+func (k Keeper) CreateNewAccount(ctx sdk.Context, projectDid ixo.Did, accountId string) (auth.Account, sdk.Error) {
+	if _, found := k.GetAccountMap(ctx, projectDid)[accountId]; found {
 		return nil, sdk.ErrInvalidAddress("Generate account already exists")
 	}
 
-	account := k.accountKeeper.NewAccountWithAddress(ctx, address)
-	k.accountKeeper.SetAccount(ctx, account)
+	address := ixo.DidAccountToAddr(projectDid, accountId)
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if account == nil {
+		account = k.accountKeeper.NewAccountWithAddress(ctx, address)
+		k.accountKeeper.SetAccount(ctx, account)
+	}
 
 	return account, nil
 }
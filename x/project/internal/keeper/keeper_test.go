@@ -4,15 +4,21 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	supplyExported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ixofoundation/ixo-cosmos/x/fees"
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
 )
 
 func TestProjectDoc(t *testing.T) {
-	ctx, k, _, _, _, _ := CreateTestInput()
+	ctx, k, _, _, _, _, _, _ := CreateTestInput()
 
 	err := k.SetProjectDoc(ctx, &types.ValidCreateProjectMsg)
 	require.Nil(t, err)
@@ -34,8 +40,117 @@ func TestProjectDoc(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+func TestKeeperUpdateProjectDocFields(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := CreateTestInput()
+
+	projectDid := types.ValidCreateProjectMsg.ProjectDid
+	original := types.ValidCreateProjectMsg.Data
+	require.Nil(t, k.SetProjectDoc(ctx, &types.ValidCreateProjectMsg))
+	require.Empty(t, k.GetProjectDocHistory(ctx, projectDid))
+
+	update := types.UpdateProjectDoc{
+		RequiredClaims:       10,
+		EvaluatorPayPerClaim: sdk.NewInt64Coin(ixo.IxoNativeToken, 500),
+		ServiceEndpoint:      "https://pds.ixo.world",
+	}
+	ctx = ctx.WithBlockHeight(5)
+	_, err := k.UpdateProjectDocFields(ctx, projectDid, update)
+	require.Nil(t, err)
+
+	doc, err := k.GetProjectDoc(ctx, projectDid)
+	require.Nil(t, err)
+	updated := doc.(*types.CreateProjectMsg).Data
+	require.Equal(t, int64(10), updated.RequiredClaims)
+	require.Equal(t, update.EvaluatorPayPerClaim, updated.EvaluatorPayPerClaim)
+	require.Equal(t, update.ServiceEndpoint, updated.ServiceEndpoint)
+	require.Equal(t, original.CreatedBy, updated.CreatedBy)
+	require.Equal(t, original.Status, updated.Status)
+
+	// The previous version is kept with the height at which it was replaced
+	ctx = ctx.WithBlockHeight(8)
+	_, err = k.UpdateProjectDocFields(ctx, projectDid, types.UpdateProjectDoc{RequiredClaims: 20})
+	require.Nil(t, err)
+	require.Equal(t, []types.ProjectDocVersion{
+		{Version: 1, Height: 5, ProjectDoc: original},
+		{Version: 2, Height: 8, ProjectDoc: updated},
+	}, k.GetProjectDocHistory(ctx, projectDid))
+	require.Equal(t, uint64(2), k.GetProjectDocHistoryCount(ctx, projectDid))
+
+	// Funded projects cannot be changed
+	doc.SetStatus(types.FundedStatus)
+	k.AddProjectDoc(ctx, doc)
+	_, err = k.UpdateProjectDocFields(ctx, projectDid, update)
+	require.NotNil(t, err)
+	require.Len(t, k.GetProjectDocHistory(ctx, projectDid), 2)
+}
+
+func TestKeeperGetProjectDocs(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := CreateTestInput()
+
+	newProject := func(projectDid, createdBy, nodeDid ixo.Did, status types.ProjectStatus) *types.CreateProjectMsg {
+		msg := types.ValidCreateProjectMsg
+		msg.ProjectDid = projectDid
+		msg.Data.CreatedBy = createdBy
+		msg.Data.NodeDid = nodeDid
+		msg.Data.Status = status
+		return &msg
+	}
+	require.Nil(t, k.SetProjectDoc(ctx, newProject("did:ixo:A", "did:ixo:alice", "did:ixo:node1", types.CreatedProject)))
+	require.Nil(t, k.SetProjectDoc(ctx, newProject("did:ixo:B", "did:ixo:alice", "did:ixo:node2", types.CreatedProject)))
+	require.Nil(t, k.SetProjectDoc(ctx, newProject("did:ixo:C", "did:ixo:bob", "did:ixo:node1", types.CreatedProject)))
+
+	query := func(status types.ProjectStatus, creatorDid, nodeDid ixo.Did, page, limit uint64) (uint64, []ixo.Did) {
+		total, docs := k.GetProjectDocs(ctx, types.NewQueryProjectsParams(status, creatorDid, nodeDid, page, limit))
+		var dids []ixo.Did
+		for _, doc := range docs {
+			dids = append(dids, doc.ProjectDid)
+		}
+		return total, dids
+	}
+
+	total, dids := query("", "", "", 1, 10)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, []ixo.Did{"did:ixo:A", "did:ixo:B", "did:ixo:C"}, dids)
+
+	total, dids = query("", "", "", 2, 2)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, []ixo.Did{"did:ixo:C"}, dids)
+
+	// Pages past the last index are empty rather than wrapping around
+	total, dids = query("", "", "", 1<<63, 2)
+	require.Equal(t, uint64(3), total)
+	require.Empty(t, dids)
+	require.NotNil(t, types.NewQueryProjectsParams("", "", "", 1<<63, 2).ValidatePagination())
+
+	total, dids = query("", "did:ixo:alice", "did:ixo:node1", 1, 10)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []ixo.Did{"did:ixo:A"}, dids)
+
+	// Status changes move the project between the status indexes
+	_, err := k.UpdateProjectDoc(ctx, newProject("did:ixo:C", "did:ixo:bob", "did:ixo:node1", types.PendingStatus))
+	require.Nil(t, err)
+
+	total, dids = query(types.CreatedProject, "", "did:ixo:node1", 1, 10)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []ixo.Did{"did:ixo:A"}, dids)
+
+	total, dids = query(types.PendingStatus, "", "", 1, 10)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []ixo.Did{"did:ixo:C"}, dids)
+
+	// The counts follow the projects between indexes
+	require.Equal(t, uint64(3), k.GetProjectCount(ctx, types.ProjectKey))
+	require.Equal(t, uint64(2), k.GetProjectCount(ctx, types.GetProjectStatusPrefixKey(types.CreatedProject)))
+	require.Equal(t, uint64(1), k.GetProjectCount(ctx, types.GetProjectStatusPrefixKey(types.PendingStatus)))
+	require.Equal(t, uint64(2), k.GetProjectCount(ctx, types.GetProjectNodePrefixKey("did:ixo:node1")))
+
+	total, dids = query("", "did:ixo:alice", "", 2, 1)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []ixo.Did{"did:ixo:B"}, dids)
+}
+
 func TestKeeperAccountMap(t *testing.T) {
-	ctx, k, cdc, _, _, _ := CreateTestInput()
+	ctx, k, cdc, _, _, _, _, _ := CreateTestInput()
 	codec.RegisterCrypto(cdc)
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "", nil)
@@ -55,7 +170,7 @@ func TestKeeperAccountMap(t *testing.T) {
 }
 
 func TestKeeperWithdrawalInfo(t *testing.T) {
-	ctx, k, cdc, _, _, _ := CreateTestInput()
+	ctx, k, cdc, _, _, _, _, _ := CreateTestInput()
 	codec.RegisterCrypto(cdc)
 
 	withdrawals, err := k.GetProjectWithdrawalTransactions(ctx, "")
@@ -69,3 +184,79 @@ func TestKeeperWithdrawalInfo(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, 2, len(withdrawals))
 }
+
+func TestKeeperFeeQuote(t *testing.T) {
+	ctx, k, _, fk, _, _, _, _ := CreateTestInput()
+	fk.SetParams(ctx, fees.DefaultParams())
+
+	_, err := k.GetFeeQuote(ctx, types.ValidCreateProjectMsg.ProjectDid, types.FeeQuoteClaim)
+	require.NotNil(t, err)
+
+	err = k.SetProjectDoc(ctx, &types.ValidCreateProjectMsg)
+	require.Nil(t, err)
+
+	quote, err := k.GetFeeQuote(ctx, types.ValidCreateProjectMsg.ProjectDid, types.FeeQuoteClaim)
+	require.Nil(t, err)
+	require.Equal(t, []types.FeePayment{
+		types.NewFeePayment(types.ValidatorsFeeRecipient, 30000000),
+		types.NewFeePayment(types.IxoAccountFeesId, 30000000),
+	}, quote.Payments)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 60000000)), quote.Total)
+
+	quote, err = k.GetFeeQuote(ctx, types.ValidCreateProjectMsg.ProjectDid, types.FeeQuoteEvaluation)
+	require.Nil(t, err)
+	require.Equal(t, []types.FeePayment{
+		types.NewFeePayment(types.ValidatorsFeeRecipient, 20000000),
+		types.NewFeePayment(types.IxoAccountFeesId, 20000000),
+		types.NewFeePayment(types.EvaluatorAccountID, 180000000),
+		types.NewFeePayment(types.InitiatingNodeAccountPayFeesId, 4000000),
+		types.NewFeePayment(types.IxoAccountPayFeesId, 16000000),
+	}, quote.Payments)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 240000000)), quote.Total)
+
+	quote, err = k.GetFeeQuote(ctx, types.ValidCreateProjectMsg.ProjectDid, types.FeeQuoteAgentRegistration)
+	require.Nil(t, err)
+	require.Empty(t, quote.Payments)
+
+	params := fees.DefaultParams()
+	params.IxoFeesToCommunityPool = true
+	fk.SetParams(ctx, params)
+
+	quote, err = k.GetFeeQuote(ctx, types.ValidCreateProjectMsg.ProjectDid, types.FeeQuoteClaim)
+	require.Nil(t, err)
+	require.Equal(t, []types.FeePayment{
+		types.NewFeePayment(types.ValidatorsFeeRecipient, 30000000),
+		types.NewFeePayment(types.CommunityPoolFeeRecipient, 30000000),
+	}, quote.Payments)
+
+	_, err = k.GetFeeQuote(ctx, types.ValidCreateProjectMsg.ProjectDid, "invalid")
+	require.NotNil(t, err)
+}
+
+func TestKeeperSendFees(t *testing.T) {
+	ctx, k, cdc, _, bk, _, _, _ := CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "cosmos-sdk/Account", nil)
+	cdc.RegisterInterface((*supplyExported.ModuleAccountI)(nil), nil)
+	cdc.RegisterConcrete(&supply.ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
+
+	_, err := bk.AddCoins(ctx, types.ValidAddress1, sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 100)))
+	require.Nil(t, err)
+
+	err = k.SendFeesToValidators(ctx, types.ValidAddress1, sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 60)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 60)),
+		k.supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins())
+
+	err = k.SendFeesToCommunityPool(ctx, types.ValidAddress1, sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 40)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 40)),
+		k.supplyKeeper.GetModuleAccount(ctx, distribution.ModuleName).GetCoins())
+	require.Equal(t, sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 40))),
+		k.distributionKeeper.GetFeePool(ctx).CommunityPool)
+
+	require.True(t, bk.GetCoins(ctx, types.ValidAddress1).IsZero())
+	err = k.SendFeesToValidators(ctx, types.ValidAddress1, sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 1)))
+	require.NotNil(t, err)
+}
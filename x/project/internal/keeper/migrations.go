@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+// StoreVersion is the version of the project store layout that this keeper
+// reads and writes. MigrateStore upgrades older stores to it.
+const StoreVersion uint64 = 4
+
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StoreVersionKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StoreVersionKey, sdk.Uint64ToBigEndian(version))
+}
+
+// MigrateStore brings the project store up to StoreVersion. It is run at the
+// start of every block, and does nothing once the store is up to date.
+func (k Keeper) MigrateStore(ctx sdk.Context) {
+	version := k.GetStoreVersion(ctx)
+	if version >= StoreVersion {
+		return
+	}
+
+	if version < 1 {
+		k.migrateProjectDocsV1(ctx)
+	}
+	if version < 2 {
+		k.indexProjectDocsV2(ctx)
+	}
+	if version < 3 {
+		k.migrateProjectAccountsV3(ctx)
+	}
+	if version < 4 {
+		k.countProjectDocsV4(ctx)
+	}
+
+	k.SetStoreVersion(ctx, StoreVersion)
+}
+
+// migrateProjectDocsV1 converts the stored project documents from string
+// fields to typed required claims, evaluator pay and creation time
+func (k Keeper) migrateProjectDocsV1(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProjectKey)
+	legacyCdc := types.LegacyProjectDocCodec()
+
+	var legacyDocs []types.CreateProjectMsgV0
+	for ; iterator.Valid(); iterator.Next() {
+		var legacyDoc types.CreateProjectMsgV0
+		legacyCdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &legacyDoc)
+		legacyDocs = append(legacyDocs, legacyDoc)
+	}
+	iterator.Close()
+
+	for _, legacyDoc := range legacyDocs {
+		projectDoc, problems := legacyDoc.Migrate()
+		if len(problems) > 0 {
+			ctx.Logger().Error("Project migrated with missing fields", "projectDid",
+				projectDoc.ProjectDid, "problems", strings.Join(problems, "; "))
+		}
+		k.setProjectDoc(ctx, &projectDoc)
+	}
+}
+
+// indexProjectDocsV2 adds the existing project documents to the status,
+// creator and node indexes
+func (k Keeper) indexProjectDocsV2(ctx sdk.Context) {
+	for _, projectDoc := range k.GetAllProjectDocs(ctx) {
+		k.addProjectDocIndexes(ctx, projectDoc)
+	}
+}
+
+// migrateProjectAccountsV3 moves the project accounts from addresses that
+// were the hex encoding of "projectDid/accountId" to the addresses derived by
+// ixo.DidAccountToAddr, moving their coins and recording each old address.
+// The account maps held the raw address bytes, which the derived addresses
+// cannot be stored as in JSON, so they now hold bech32 addresses.
+func (k Keeper) migrateProjectAccountsV3(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AccountKey)
+
+	var projectDids []ixo.Did
+	for ; iterator.Valid(); iterator.Next() {
+		projectDids = append(projectDids, string(iterator.Key()[len(types.AccountKey):]))
+	}
+	iterator.Close()
+
+	for _, projectDid := range projectDids {
+		accountMap := k.GetAccountMap(ctx, projectDid)
+
+		// Accounts are created in a fixed order so that account numbers
+		// are the same on every node
+		accountIds := make([]string, 0, len(accountMap))
+		for accountId := range accountMap {
+			accountIds = append(accountIds, accountId)
+		}
+		sort.Strings(accountIds)
+
+		for _, accountId := range accountIds {
+			oldAddress := sdk.AccAddress(accountMap[accountId].(string))
+			newAddress := ixo.DidAccountToAddr(projectDid, accountId)
+
+			newAccount := k.accountKeeper.GetAccount(ctx, newAddress)
+			if newAccount == nil {
+				newAccount = k.accountKeeper.NewAccountWithAddress(ctx, newAddress)
+			}
+
+			oldAccount := k.accountKeeper.GetAccount(ctx, oldAddress)
+			if oldAccount != nil && !oldAddress.Equals(newAddress) {
+				if err := newAccount.SetCoins(newAccount.GetCoins().Add(oldAccount.GetCoins())); err != nil {
+					panic(err)
+				}
+				k.accountKeeper.RemoveAccount(ctx, oldAccount)
+			}
+			k.accountKeeper.SetAccount(ctx, newAccount)
+
+			accountMap[accountId] = newAddress.String()
+			k.setMigratedAccount(ctx, oldAddress, newAddress)
+		}
+
+		k.setAccountMap(ctx, projectDid, accountMap)
+	}
+}
+
+// countProjectDocsV4 recounts the stored projects and the projects of each
+// status, creator and node index
+func (k Keeper) countProjectDocsV4(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProjectCountKey)
+
+	var countKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		countKeys = append(countKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range countKeys {
+		store.Delete(key)
+	}
+
+	for _, projectDoc := range k.GetAllProjectDocs(ctx) {
+		k.incrementProjectCount(ctx, types.ProjectKey)
+		for _, prefix := range projectDocIndexPrefixes(projectDoc) {
+			k.incrementProjectCount(ctx, prefix)
+		}
+	}
+}
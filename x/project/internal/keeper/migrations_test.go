@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+func TestMigrateProjectDocs(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := CreateTestInput()
+
+	legacyDoc := types.CreateProjectMsgV0{
+		ProjectDid: "6iftm1hHdaU6LJGKayRMev",
+		PubKey:     "47mm6LCDAyJmqkbUbqGoZKZkBixjBgvDFRMwQRF9HWMU",
+		Data: types.ProjectDocV0{
+			NodeDid:              "Tu2QWRHuDufywDALbBQ2r",
+			RequiredClaims:       "100",
+			EvaluatorPayPerClaim: "10",
+			CreatedOn:            "2018-05-21T15:53:18.484Z",
+			CreatedBy:            "6Fu7FbbGoCJ8tX3vMMCss9",
+			Status:               types.CreatedProject,
+		},
+	}
+	malformedDoc := legacyDoc
+	malformedDoc.ProjectDid = "4XJLBfGtWSGKSz4BeRxdun"
+	malformedDoc.Data.RequiredClaims = "requireClaims1"
+	malformedDoc.Data.EvaluatorPayPerClaim = ""
+	malformedDoc.Data.CreatedOn = "time1"
+
+	// Legacy docs were stored as the registered project message, so they
+	// carry its amino prefix
+	legacyCdc := codec.New()
+	legacyCdc.RegisterConcrete(types.CreateProjectMsgV0{}, "ixo-cosmos/MsgCreateProject", nil)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, doc := range []types.CreateProjectMsgV0{legacyDoc, malformedDoc} {
+		doc := doc
+		store.Set(types.GetProjectPrefixKey(doc.ProjectDid), legacyCdc.MustMarshalBinaryLengthPrefixed(&doc))
+	}
+
+	k.MigrateStore(ctx)
+	require.Equal(t, StoreVersion, k.GetStoreVersion(ctx))
+
+	doc, err := k.GetProjectDoc(ctx, legacyDoc.ProjectDid)
+	require.Nil(t, err)
+	projectDoc := doc.(*types.CreateProjectMsg).Data
+	require.Equal(t, int64(100), projectDoc.RequiredClaims)
+	require.Equal(t, sdk.NewInt64Coin(ixo.IxoNativeToken, 1000000000), projectDoc.EvaluatorPayPerClaim)
+	require.Equal(t, time.Date(2018, 5, 21, 15, 53, 18, 484000000, time.UTC), projectDoc.CreatedOn)
+	require.Nil(t, projectDoc.Validate())
+
+	// Unparseable fields are left empty instead of stopping the migration
+	doc, err = k.GetProjectDoc(ctx, malformedDoc.ProjectDid)
+	require.Nil(t, err)
+	projectDoc = doc.(*types.CreateProjectMsg).Data
+	require.Equal(t, int64(0), projectDoc.RequiredClaims)
+	require.True(t, projectDoc.GetEvaluatorPay().IsZero())
+	require.True(t, projectDoc.CreatedOn.IsZero())
+
+	// Migrated projects are added to the indexes
+	total, _ := k.GetProjectDocs(ctx, types.NewQueryProjectsParams(types.CreatedProject,
+		"", legacyDoc.Data.NodeDid, 1, types.DefaultProjectsPerPage))
+	require.Equal(t, uint64(2), total)
+
+	// Migrating again is a no-op
+	k.MigrateStore(ctx)
+	doc, err = k.GetProjectDoc(ctx, legacyDoc.ProjectDid)
+	require.Nil(t, err)
+	require.Equal(t, int64(100), doc.(*types.CreateProjectMsg).Data.RequiredClaims)
+}
+
+func TestMigrateProjectCounts(t *testing.T) {
+	ctx, k, _, _, _, _, _, _ := CreateTestInput()
+
+	projectDoc := types.ValidCreateProjectMsg
+	k.setProjectDoc(ctx, &projectDoc)
+	k.SetStoreVersion(ctx, 1)
+
+	// Stores indexed before projects were counted are recounted
+	k.MigrateStore(ctx)
+	require.Equal(t, uint64(1), k.GetProjectCount(ctx, types.ProjectKey))
+	for _, prefix := range projectDocIndexPrefixes(&projectDoc) {
+		require.Equal(t, uint64(1), k.GetProjectCount(ctx, prefix))
+	}
+
+	total, docs := k.GetProjectDocs(ctx, types.NewQueryProjectsParams(projectDoc.GetStatus(),
+		"", "", 1, types.DefaultProjectsPerPage))
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []types.CreateProjectMsg{projectDoc}, docs)
+}
+
+func TestMigrateProjectAccounts(t *testing.T) {
+	ctx, k, cdc, _, _, _, _, _ := CreateTestInput()
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "", nil)
+	k.SetStoreVersion(ctx, 2)
+
+	projectDid := types.ValidCreateProjectMsg.ProjectDid
+	coins := sdk.NewCoins(sdk.NewInt64Coin(ixo.IxoNativeToken, 100))
+
+	// Accounts created before addresses were derived with ixo.DidAccountToAddr
+	legacyAddress := func(accountId string) sdk.AccAddress {
+		return sdk.AccAddress(hex.EncodeToString([]byte(projectDid + "/" + accountId)))
+	}
+	accountMap := make(map[string]interface{})
+	for _, accountId := range []string{projectDid, types.IxoAccountFeesId} {
+		account := k.accountKeeper.NewAccountWithAddress(ctx, legacyAddress(accountId))
+		require.Nil(t, account.SetCoins(coins))
+		k.accountKeeper.SetAccount(ctx, account)
+		accountMap[accountId] = string(account.GetAddress().Bytes())
+	}
+	k.setAccountMap(ctx, projectDid, accountMap)
+
+	k.MigrateStore(ctx)
+	require.Equal(t, StoreVersion, k.GetStoreVersion(ctx))
+
+	accountMap = k.GetAccountMap(ctx, projectDid)
+	for _, accountId := range []string{projectDid, types.IxoAccountFeesId} {
+		newAddress := ixo.DidAccountToAddr(projectDid, accountId)
+		require.Equal(t, newAddress.String(), accountMap[accountId])
+		require.Equal(t, coins, k.accountKeeper.GetAccount(ctx, newAddress).GetCoins())
+		require.Nil(t, k.accountKeeper.GetAccount(ctx, legacyAddress(accountId)))
+
+		migratedAccount, err := k.GetMigratedAccount(ctx, legacyAddress(accountId))
+		require.Nil(t, err)
+		require.Equal(t, newAddress, migratedAccount.NewAddress)
+	}
+
+	// The project's own account is the one its DID has in every module
+	require.Equal(t, ixo.DidToAddr(projectDid), ixo.DidAccountToAddr(projectDid, projectDid))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+func (k Keeper) GetProjectDocHistory(ctx sdk.Context, projectDid ixo.Did) (history []types.ProjectDocVersion) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetProjectDocHistoryPrefixKey(projectDid))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var version types.ProjectDocVersion
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &version)
+		history = append(history, version)
+	}
+	return history
+}
+
+func (k Keeper) GetProjectDocHistoryCount(ctx sdk.Context, projectDid ixo.Did) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetProjectDocHistoryCountKey(projectDid))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetProjectDocHistoryCount(ctx sdk.Context, projectDid ixo.Did, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProjectDocHistoryCountKey(projectDid), sdk.Uint64ToBigEndian(count))
+}
+
+func (k Keeper) AddProjectDocVersion(ctx sdk.Context, projectDid ixo.Did, version types.ProjectDocVersion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProjectDocVersionKey(projectDid, version.Version), k.cdc.MustMarshalBinaryLengthPrefixed(version))
+}
+
+// UpdateProjectDocFields replaces the mutable fields of a project that has
+// not been funded yet. The replaced document is added to the project's
+// history, versioned from 1 in the order of the updates.
+func (k Keeper) UpdateProjectDocFields(ctx sdk.Context, projectDid ixo.Did,
+	update types.UpdateProjectDoc) (types.StoredProjectDoc, sdk.Error) {
+
+	storedDoc, err := k.GetProjectDoc(ctx, projectDid)
+	if err != nil {
+		return nil, err
+	}
+
+	projectDoc := storedDoc.(*types.CreateProjectMsg)
+	if !projectDoc.GetStatus().AllowsDocUpdates() {
+		return nil, types.ErrInvalidProjectDoc(fmt.Sprintf(
+			"Project doc cannot be updated in status %s", projectDoc.GetStatus()))
+	}
+
+	version := k.GetProjectDocHistoryCount(ctx, projectDid) + 1
+	k.SetProjectDocHistoryCount(ctx, projectDid, version)
+	k.AddProjectDocVersion(ctx, projectDid, types.ProjectDocVersion{
+		Version:    version,
+		Height:     ctx.BlockHeight(),
+		ProjectDoc: projectDoc.Data,
+	})
+
+	projectDoc.Data = projectDoc.Data.Update(update)
+	k.AddProjectDoc(ctx, projectDoc)
+
+	return projectDoc, nil
+}
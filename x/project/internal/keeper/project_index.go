@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+// projectDocIndexPrefixes returns the prefixes of the status, creator and
+// node indexes that the project doc is in
+func projectDocIndexPrefixes(projectDoc types.StoredProjectDoc) [][]byte {
+	return [][]byte{
+		types.GetProjectStatusPrefixKey(projectDoc.GetStatus()),
+		types.GetProjectCreatorPrefixKey(projectDoc.GetCreatedBy()),
+		types.GetProjectNodePrefixKey(projectDoc.GetNodeDid()),
+	}
+}
+
+func (k Keeper) addProjectDocIndexes(ctx sdk.Context, projectDoc types.StoredProjectDoc) {
+	store := ctx.KVStore(k.storeKey)
+	projectDid := projectDoc.GetProjectDid()
+	for _, prefix := range projectDocIndexPrefixes(projectDoc) {
+		store.Set(append(prefix, []byte(projectDid)...), []byte{})
+		k.incrementProjectCount(ctx, prefix)
+	}
+}
+
+func (k Keeper) removeProjectDocIndexes(ctx sdk.Context, projectDoc types.StoredProjectDoc) {
+	store := ctx.KVStore(k.storeKey)
+	projectDid := projectDoc.GetProjectDid()
+	for _, prefix := range projectDocIndexPrefixes(projectDoc) {
+		store.Delete(append(prefix, []byte(projectDid)...))
+		k.decrementProjectCount(ctx, prefix)
+	}
+}
+
+// GetProjectCount returns the number of projects stored under the prefix,
+// which is ProjectKey or the prefix of an index
+func (k Keeper) GetProjectCount(ctx sdk.Context, prefix []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetProjectCountKey(prefix))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setProjectCount(ctx sdk.Context, prefix []byte, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.GetProjectCountKey(prefix))
+	} else {
+		store.Set(types.GetProjectCountKey(prefix), sdk.Uint64ToBigEndian(count))
+	}
+}
+
+func (k Keeper) incrementProjectCount(ctx sdk.Context, prefix []byte) {
+	k.setProjectCount(ctx, prefix, k.GetProjectCount(ctx, prefix)+1)
+}
+
+func (k Keeper) decrementProjectCount(ctx sdk.Context, prefix []byte) {
+	if count := k.GetProjectCount(ctx, prefix); count > 0 {
+		k.setProjectCount(ctx, prefix, count-1)
+	}
+}
+
+func (k Keeper) GetAllProjectDocs(ctx sdk.Context) (projectDocs []types.StoredProjectDoc) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProjectKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var projectDoc types.CreateProjectMsg
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &projectDoc)
+		projectDocs = append(projectDocs, &projectDoc)
+	}
+	return projectDocs
+}
+
+// GetProjectDocs returns the total number of projects matching the params and
+// the requested page of them. The given criterion with the fewest projects
+// is scanned. With at most one criterion the total is the stored count of
+// its index and only the projects of the page are read; otherwise the
+// remaining criteria are checked per project of the scanned index.
+func (k Keeper) GetProjectDocs(ctx sdk.Context, params types.QueryProjectsParams) (
+	total uint64, projectDocs []types.CreateProjectMsg) {
+
+	start, end := ixo.PageBounds(params.Page, params.Limit)
+
+	prefixes := queryPrefixes(params)
+	prefix := types.ProjectKey
+	if len(prefixes) > 0 {
+		prefix = prefixes[0]
+		for _, p := range prefixes[1:] {
+			if k.GetProjectCount(ctx, p) < k.GetProjectCount(ctx, prefix) {
+				prefix = p
+			}
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	if len(prefixes) <= 1 {
+		total = k.GetProjectCount(ctx, prefix)
+		for i := uint64(0); iterator.Valid() && i < end; iterator.Next() {
+			if i >= start {
+				projectDocs = append(projectDocs, k.mustGetProjectDoc(ctx, ixo.Did(iterator.Key()[len(prefix):])))
+			}
+			i++
+		}
+		return total, projectDocs
+	}
+
+	for ; iterator.Valid(); iterator.Next() {
+		projectDoc := k.mustGetProjectDoc(ctx, ixo.Did(iterator.Key()[len(prefix):]))
+		if !params.Matches(&projectDoc) {
+			continue
+		}
+
+		if total >= start && total < end {
+			projectDocs = append(projectDocs, projectDoc)
+		}
+		total++
+	}
+
+	return total, projectDocs
+}
+
+// queryPrefixes returns the prefixes of the indexes of the given criteria
+func queryPrefixes(params types.QueryProjectsParams) (prefixes [][]byte) {
+	if params.CreatorDid != "" {
+		prefixes = append(prefixes, types.GetProjectCreatorPrefixKey(params.CreatorDid))
+	}
+	if params.NodeDid != "" {
+		prefixes = append(prefixes, types.GetProjectNodePrefixKey(params.NodeDid))
+	}
+	if params.Status != types.NullStatus {
+		prefixes = append(prefixes, types.GetProjectStatusPrefixKey(params.Status))
+	}
+	return prefixes
+}
+
+func (k Keeper) mustGetProjectDoc(ctx sdk.Context, projectDid ixo.Did) types.CreateProjectMsg {
+	projectDoc, err := k.GetProjectDoc(ctx, projectDid)
+	if err != nil {
+		panic(err)
+	}
+	return *projectDoc.(*types.CreateProjectMsg)
+}
@@ -1,18 +1,32 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/abci/types"
+
+	projectTypes "github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
 )
 
 const (
-	QueryProjectDoc     = "queryProjectDoc"
-	QueryProjectAccount = "queryProjectAccount"
-	QueryProjectTx      = "queryProjectTx"
+	QueryProjectDoc        = "queryProjectDoc"
+	QueryProjectDocHistory = "queryProjectDocHistory"
+	QueryProjectDocs       = "queryProjectDocs"
+	QueryProjectAccount    = "queryProjectAccount"
+	QueryMigratedAccount   = "queryMigratedAccount"
+	QueryProjectTx         = "queryProjectTx"
+	QueryWithdrawal        = "queryWithdrawal"
+	QueryWithdrawals       = "queryWithdrawals"
+
+	QueryEthDeposit         = "queryEthDeposit"
+	QueryPendingEthDeposits = "queryPendingEthDeposits"
+
+	QueryFeeQuote = "queryFeeQuote"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -20,10 +34,26 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case QueryProjectDoc:
 			return queryProjectDoc(ctx, path[1:], k)
+		case QueryProjectDocHistory:
+			return queryProjectDocHistory(ctx, path[1:], k)
+		case QueryProjectDocs:
+			return queryProjectDocs(ctx, req, k)
 		case QueryProjectAccount:
 			return queryProjectAccount(ctx, path[1:], k)
+		case QueryMigratedAccount:
+			return queryMigratedAccount(ctx, path[1:], k)
 		case QueryProjectTx:
 			return queryProjectTx(ctx, path[1:], k)
+		case QueryWithdrawal:
+			return queryWithdrawal(ctx, path[1:], k)
+		case QueryWithdrawals:
+			return queryWithdrawals(ctx, path[1:], k)
+		case QueryEthDeposit:
+			return queryEthDeposit(ctx, path[1:], k)
+		case QueryPendingEthDeposits:
+			return queryPendingEthDeposits(ctx, k)
+		case QueryFeeQuote:
+			return queryFeeQuote(ctx, path[1:], k)
 		default:
 			return nil, sdk.ErrUnknownRequest("Unknown project query endpoint")
 		}
@@ -44,6 +74,55 @@ func queryProjectDoc(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Erro
 	return res, nil
 }
 
+func queryProjectDocHistory(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	_, err := k.GetProjectDoc(ctx, path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	history := k.GetProjectDocHistory(ctx, path[0])
+	if history == nil {
+		history = []projectTypes.ProjectDocVersion{}
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, history)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+
+	return res, nil
+}
+
+func queryProjectDocs(ctx sdk.Context, req types.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params projectTypes.QueryProjectsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if err := params.ValidatePagination(); err != nil {
+		return nil, projectTypes.ErrInvalidPagination(err.Error())
+	}
+
+	total, projectDocs := k.GetProjectDocs(ctx, params)
+	if projectDocs == nil {
+		projectDocs = []projectTypes.CreateProjectMsg{}
+	}
+
+	result := projectTypes.QueryProjectsResult{
+		Total:    total,
+		Page:     params.Page,
+		Limit:    params.Limit,
+		Projects: projectDocs,
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, result)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+
+	return res, nil
+}
+
 func queryProjectAccount(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
 
 	resp := k.GetAccountMap(ctx, path[0])
@@ -55,6 +134,25 @@ func queryProjectAccount(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.
 	return res, nil
 }
 
+func queryMigratedAccount(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	oldAddress, errRes := hex.DecodeString(path[0])
+	if errRes != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("old address must be hex encoded: %s", errRes))
+	}
+
+	migratedAccount, err := k.GetMigratedAccount(ctx, oldAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, migratedAccount)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes))
+	}
+
+	return res, nil
+}
+
 func queryProjectTx(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
 	info, err := k.GetProjectWithdrawalTransactions(ctx, path[0])
 	if err != nil {
@@ -68,3 +166,91 @@ func queryProjectTx(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error
 
 	return res, nil
 }
+
+func queryWithdrawal(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	id, errRes := strconv.ParseUint(path[0], 10, 64)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid withdrawal id %s", path[0]))
+	}
+
+	withdrawal, err := k.GetWithdrawal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, withdrawal)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryWithdrawals(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	status := projectTypes.WithdrawalStatus(path[0])
+	if !status.IsValid() {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid withdrawal status %s", path[0]))
+	}
+
+	withdrawals := k.GetWithdrawalsByStatus(ctx, status)
+	if withdrawals == nil {
+		withdrawals = []projectTypes.Withdrawal{}
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, withdrawals)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryEthDeposit(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) < 2 {
+		return nil, sdk.ErrUnknownRequest("Project DID and eth tx hash are required")
+	}
+
+	deposit, err := k.GetEthDeposit(ctx, path[0], path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, deposit)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryPendingEthDeposits(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	deposits := k.GetPendingEthDeposits(ctx)
+	if deposits == nil {
+		deposits = []projectTypes.EthDeposit{}
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, deposits)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
+
+func queryFeeQuote(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) != 2 {
+		return nil, sdk.ErrUnknownRequest("fee quote query needs a project did and msg type")
+	}
+
+	quote, err := k.GetFeeQuote(ctx, path[0], projectTypes.FeeQuoteMsgType(path[1]))
+	if err != nil {
+		return nil, err
+	}
+
+	res, errRes := codec.MarshalJSONIndent(k.cdc, quote)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal data %s", errRes.Error()))
+	}
+
+	return res, nil
+}
@@ -6,22 +6,32 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
 	cParams "github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmDB "github.com/tendermint/tm-db"
 
 	"github.com/ixofoundation/ixo-cosmos/x/fees"
+	"github.com/ixofoundation/ixo-cosmos/x/node"
 	"github.com/ixofoundation/ixo-cosmos/x/params"
 	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
 )
 
-func CreateTestInput() (sdk.Context, Keeper, *codec.Codec, fees.Keeper, bank.Keeper, params.Keeper) {
+func CreateTestInput() (sdk.Context, Keeper, *codec.Codec, fees.Keeper, bank.Keeper, params.Keeper, staking.Keeper,
+	node.Keeper) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	actStoreKey := sdk.NewKVStoreKey(auth.StoreKey)
 	keyParam := sdk.NewKVStoreKey(params.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 	keyFee := sdk.NewKVStoreKey(fees.StoreKey)
+	supplyStoreKey := sdk.NewKVStoreKey(supply.StoreKey)
+	stakingStoreKey := sdk.NewKVStoreKey(staking.StoreKey)
+	stakingTStoreKey := sdk.NewTransientStoreKey(staking.TStoreKey)
+	distrStoreKey := sdk.NewKVStoreKey(distribution.StoreKey)
+	nodeStoreKey := sdk.NewKVStoreKey(node.StoreKey)
 
 	db := tmDB.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -30,6 +40,11 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec, fees.Keeper, bank.Kee
 	ms.MountStoreWithDB(keyParam, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeIAVL, nil)
 	ms.MountStoreWithDB(keyFee, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(supplyStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(stakingStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(stakingTStoreKey, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(distrStoreKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(nodeStoreKey, sdk.StoreTypeIAVL, nil)
 	_ = ms.LoadLatestVersion()
 
 	ctx := sdk.NewContext(ms, abciTypes.Header{}, true, log.NewNopLogger())
@@ -42,10 +57,27 @@ func CreateTestInput() (sdk.Context, Keeper, *codec.Codec, fees.Keeper, bank.Kee
 		cdc, actStoreKey, pk1.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount,
 	)
 	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk1.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, nil)
-	feeKeeper := fees.NewKeeper(cdc, paramsKeeper)
-	keeper := NewKeeper(cdc, storeKey, accountKeeper, feeKeeper)
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName:     nil,
+		distribution.ModuleName:   nil,
+		node.ModuleName:           nil,
+		staking.BondedPoolName:    {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+	}
+	supplyKeeper := supply.NewKeeper(cdc, supplyStoreKey, accountKeeper, bankKeeper, maccPerms)
+	stakingKeeper := staking.NewKeeper(cdc, stakingStoreKey, stakingTStoreKey,
+		supplyKeeper, pk1.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 
-	return ctx, keeper, cdc, feeKeeper, bankKeeper, paramsKeeper
+	distributionKeeper := distribution.NewKeeper(cdc, distrStoreKey, pk1.Subspace(distribution.DefaultParamspace),
+		stakingKeeper, supplyKeeper, distribution.DefaultCodespace, auth.FeeCollectorName, nil)
+	distributionKeeper.SetFeePool(ctx, distribution.InitialFeePool())
+
+	feeKeeper := fees.NewKeeper(cdc, pk1.Subspace(fees.DefaultParamspace))
+	keeper := NewKeeper(cdc, storeKey, accountKeeper, feeKeeper, stakingKeeper, supplyKeeper, distributionKeeper)
+
+	nodeKeeper := node.NewKeeper(cdc, nodeStoreKey, paramsKeeper, supplyKeeper)
+
+	return ctx, keeper, cdc, feeKeeper, bankKeeper, paramsKeeper, stakingKeeper, nodeKeeper
 }
 
 func MakeTestCodec() *codec.Codec {
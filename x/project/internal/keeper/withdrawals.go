@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+	"github.com/ixofoundation/ixo-cosmos/x/project/internal/types"
+)
+
+func (k Keeper) GetWithdrawalCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.WithdrawalCountKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetWithdrawalCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.WithdrawalCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+func (k Keeper) GetWithdrawal(ctx sdk.Context, id uint64) (types.Withdrawal, sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawalQueueKey(id))
+	if bz == nil {
+		return types.Withdrawal{}, sdk.ErrUnknownRequest(fmt.Sprintf("Withdrawal %d does not exist", id))
+	}
+
+	var withdrawal types.Withdrawal
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &withdrawal)
+
+	return withdrawal, nil
+}
+
+// SetWithdrawal stores the withdrawal and moves it to the status index of
+// its current status
+func (k Keeper) SetWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) {
+	store := ctx.KVStore(k.storeKey)
+
+	existing, err := k.GetWithdrawal(ctx, withdrawal.ID)
+	if err == nil {
+		store.Delete(types.GetWithdrawalStatusIndexKey(existing.Status, existing.ID))
+	}
+
+	store.Set(types.GetWithdrawalQueueKey(withdrawal.ID), k.cdc.MustMarshalBinaryLengthPrefixed(withdrawal))
+	store.Set(types.GetWithdrawalStatusIndexKey(withdrawal.Status, withdrawal.ID), []byte{})
+}
+
+// QueueWithdrawal adds a pending withdrawal to the outbound queue and returns it
+func (k Keeper) QueueWithdrawal(ctx sdk.Context, projectDid ixo.Did, accountID string,
+	recipientEthAddress string, amount sdk.Coin) types.Withdrawal {
+
+	id := k.GetWithdrawalCount(ctx)
+	withdrawal := types.NewWithdrawal(id, projectDid, accountID,
+		recipientEthAddress, amount, ctx.BlockHeight())
+
+	k.SetWithdrawal(ctx, withdrawal)
+	k.SetWithdrawalCount(ctx, id+1)
+
+	return withdrawal
+}
+
+func (k Keeper) GetAllWithdrawals(ctx sdk.Context) (withdrawals []types.Withdrawal) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.WithdrawalQueueKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal types.Withdrawal
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &withdrawal)
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals
+}
+
+// GetWithdrawalsByStatus returns the withdrawals with the status, oldest first
+func (k Keeper) GetWithdrawalsByStatus(ctx sdk.Context, status types.WithdrawalStatus) (withdrawals []types.Withdrawal) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetWithdrawalStatusPrefixKey(status)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := binary.BigEndian.Uint64(iterator.Key()[len(prefix):])
+		withdrawal, err := k.GetWithdrawal(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals
+}
@@ -11,6 +11,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(CreateEvaluationMsg{}, "ixo-cosmos/CreateEvaluationMsg", nil)
 	cdc.RegisterConcrete(UpdateAgentMsg{}, "ixo-cosmos/UpdateAgentMsg", nil)
 	cdc.RegisterConcrete(UpdateProjectStatusMsg{}, "ixo-cosmos/UpdateProjectStatusMsg", nil)
+	cdc.RegisterConcrete(UpdateProjectDocMsg{}, "ixo-cosmos/UpdateProjectDocMsg", nil)
+	cdc.RegisterConcrete(UpdateWithdrawalStatusMsg{}, "ixo-cosmos/UpdateWithdrawalStatusMsg", nil)
+	cdc.RegisterConcrete(MsgAttestEthDeposit{}, "ixo-cosmos/MsgAttestEthDeposit", nil)
 }
 
 var ModuleCdc *codec.Codec
@@ -6,4 +6,15 @@ import (
 
 const (
 	DefaultCodeSpace sdk.CodespaceType = ModuleName
+
+	CodeInvalidProjectDoc sdk.CodeType = 201
+	CodeInvalidPagination sdk.CodeType = 202
 )
+
+func ErrInvalidProjectDoc(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeInvalidProjectDoc, msg)
+}
+
+func ErrInvalidPagination(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodeSpace, CodeInvalidPagination, msg)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+type EthDepositStatus string
+
+const (
+	EthDepositPending  EthDepositStatus = "pending"
+	EthDepositApplied  EthDepositStatus = "applied"
+	EthDepositRejected EthDepositStatus = "rejected"
+)
+
+// EthDepositAttestation is a validator's view of a project funding transfer
+type EthDepositAttestation struct {
+	Validator        sdk.ValAddress `json:"validator"`
+	ProjectEthWallet string         `json:"projectEthWallet"`
+	Amount           sdk.Int        `json:"amount"`
+}
+
+func (a EthDepositAttestation) Agrees(other EthDepositAttestation) bool {
+	return a.ProjectEthWallet == other.ProjectEthWallet && a.Amount.Equal(other.Amount)
+}
+
+// EthDeposit is a project funding transfer on Ethereum that is waiting for,
+// or has reached, a quorum of validator attestations. The project is only
+// funded once validators holding more than 2/3 of the voting power agree on
+// the amount and project wallet of the transfer. Deposits are kept per
+// project, so a transfer submitted by another project cannot block it.
+// A deposit whose quorum is reached when the project cannot be funded is
+// rejected, and the project can then submit the transfer again.
+type EthDeposit struct {
+	EthTxHash        string                  `json:"ethTxHash"`
+	ProjectDid       ixo.Did                 `json:"projectDid"`
+	Status           EthDepositStatus        `json:"status"`
+	Height           int64                   `json:"height"`
+	ProjectEthWallet string                  `json:"projectEthWallet"`
+	Amount           sdk.Int                 `json:"amount"`
+	Attestations     []EthDepositAttestation `json:"attestations"`
+	RejectionReason  string                  `json:"rejectionReason"`
+}
+
+func NewEthDeposit(ethTxHash string, projectDid ixo.Did, height int64) EthDeposit {
+	return EthDeposit{
+		EthTxHash:    ethTxHash,
+		ProjectDid:   projectDid,
+		Status:       EthDepositPending,
+		Height:       height,
+		Amount:       sdk.ZeroInt(),
+		Attestations: []EthDepositAttestation{},
+	}
+}
+
+func (d EthDeposit) HasAttestationFrom(validator sdk.ValAddress) bool {
+	for _, a := range d.Attestations {
+		if a.Validator.Equals(validator) {
+			return true
+		}
+	}
+	return false
+}
+
+// EthDepositSource looks up project funding transfers on Ethereum. It is
+// used off-chain by validators to decide what to attest to.
+type EthDepositSource interface {
+	GetProjectFunding(ethTxHash string, projectDid ixo.Did) (projectEthWallet string, amount sdk.Int, err error)
+}
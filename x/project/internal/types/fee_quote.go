@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+type FeeQuoteMsgType string
+
+const (
+	FeeQuoteClaim             FeeQuoteMsgType = "claim"
+	FeeQuoteEvaluation        FeeQuoteMsgType = "evaluation"
+	FeeQuoteAgentRegistration FeeQuoteMsgType = "agentRegistration"
+
+	// EvaluatorAccountID stands in for the project account of the evaluator,
+	// which is only known once an evaluation is submitted
+	EvaluatorAccountID InternalAccountID = "Evaluator"
+
+	// Fees paid to the validators are distributed by stake, to validators and
+	// their delegators, by the distribution module
+	ValidatorsFeeRecipient    InternalAccountID = "Validators"
+	CommunityPoolFeeRecipient InternalAccountID = "CommunityPool"
+)
+
+func (t FeeQuoteMsgType) IsValid() bool {
+	return t == FeeQuoteClaim || t == FeeQuoteEvaluation || t == FeeQuoteAgentRegistration
+}
+
+// FeePayment is an amount paid out of the project account to one of the
+// project's internal accounts
+type FeePayment struct {
+	Recipient InternalAccountID `json:"recipient"`
+	Amount    sdk.Coin          `json:"amount"`
+}
+
+func NewFeePayment(recipient InternalAccountID, amount int64) FeePayment {
+	return FeePayment{
+		Recipient: recipient,
+		Amount:    sdk.NewInt64Coin(ixo.IxoNativeToken, amount),
+	}
+}
+
+// FeeQuote lists the exact payments made out of the project account when a
+// message of the given type is processed for the project
+type FeeQuote struct {
+	ProjectDid ixo.Did         `json:"projectDid"`
+	MsgType    FeeQuoteMsgType `json:"msgType"`
+	Payments   []FeePayment    `json:"payments"`
+	Total      sdk.Coins       `json:"total"`
+}
+
+func NewFeeQuote(projectDid ixo.Did, msgType FeeQuoteMsgType, payments []FeePayment) FeeQuote {
+	total := sdk.NewCoins()
+	for _, p := range payments {
+		total = total.Add(sdk.NewCoins(p.Amount))
+	}
+
+	return FeeQuote{
+		ProjectDid: projectDid,
+		MsgType:    msgType,
+		Payments:   payments,
+		Total:      total,
+	}
+}
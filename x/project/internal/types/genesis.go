@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState holds the outbound withdrawal queue and the ID that the next
+// queued withdrawal will get
+type GenesisState struct {
+	Withdrawals     []Withdrawal `json:"withdrawals"`
+	WithdrawalCount uint64       `json:"withdrawalCount"`
+}
+
+func NewGenesisState(withdrawals []Withdrawal, withdrawalCount uint64) GenesisState {
+	return GenesisState{
+		Withdrawals:     withdrawals,
+		WithdrawalCount: withdrawalCount,
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	ids := make(map[uint64]bool)
+	for _, w := range data.Withdrawals {
+		if ids[w.ID] {
+			return fmt.Errorf("duplicate withdrawal %d", w.ID)
+		}
+		ids[w.ID] = true
+
+		if w.ID >= data.WithdrawalCount {
+			return fmt.Errorf("withdrawal %d is not below the withdrawal count %d", w.ID, data.WithdrawalCount)
+		} else if !w.Status.IsValid() {
+			return fmt.Errorf("withdrawal %d has invalid status %s", w.ID, w.Status)
+		} else if !w.Amount.IsValid() {
+			return fmt.Errorf("withdrawal %d has invalid amount %s", w.ID, w.Amount)
+		}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Withdrawals:     nil,
+		WithdrawalCount: 0,
+	}
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
 
@@ -15,16 +19,105 @@ var (
 	ProjectKey    = []byte{0x01}
 	AccountKey    = []byte{0x02}
 	WithdrawalKey = []byte{0x03}
+
+	WithdrawalQueueKey       = []byte{0x04}
+	WithdrawalStatusIndexKey = []byte{0x05}
+	WithdrawalCountKey       = []byte{0x06}
+
+	EthDepositKey = []byte{0x07}
+
+	StoreVersionKey = []byte{0x08}
+
+	ProjectDocHistoryKey = []byte{0x09}
+
+	ProjectStatusIndexKey  = []byte{0x0A}
+	ProjectCreatorIndexKey = []byte{0x0B}
+	ProjectNodeIndexKey    = []byte{0x0C}
+
+	MigratedAccountKey = []byte{0x0D}
+
+	ProjectCountKey = []byte{0x0E}
+
+	ProjectDocHistoryCountKey = []byte{0x0F}
+
+	AppliedEthTxKey = []byte{0x10}
 )
 
 func GetProjectPrefixKey(did ixo.Did) []byte {
 	return append(ProjectKey, []byte(did)...)
 }
 
+func GetProjectDocHistoryPrefixKey(did ixo.Did) []byte {
+	return append(append(ProjectDocHistoryKey, []byte(did)...), byte('/'))
+}
+
+func GetProjectDocVersionKey(did ixo.Did, version uint64) []byte {
+	return append(GetProjectDocHistoryPrefixKey(did), sdk.Uint64ToBigEndian(version)...)
+}
+
+func GetProjectDocHistoryCountKey(did ixo.Did) []byte {
+	return append(ProjectDocHistoryCountKey, []byte(did)...)
+}
+
+func GetProjectStatusPrefixKey(status ProjectStatus) []byte {
+	return append(append(ProjectStatusIndexKey, []byte(status)...), byte('/'))
+}
+
+func GetProjectStatusIndexKey(status ProjectStatus, projectDid ixo.Did) []byte {
+	return append(GetProjectStatusPrefixKey(status), []byte(projectDid)...)
+}
+
+func GetProjectCreatorPrefixKey(creatorDid ixo.Did) []byte {
+	return append(append(ProjectCreatorIndexKey, []byte(creatorDid)...), byte('/'))
+}
+
+func GetProjectCreatorIndexKey(creatorDid ixo.Did, projectDid ixo.Did) []byte {
+	return append(GetProjectCreatorPrefixKey(creatorDid), []byte(projectDid)...)
+}
+
+func GetProjectNodePrefixKey(nodeDid ixo.Did) []byte {
+	return append(append(ProjectNodeIndexKey, []byte(nodeDid)...), byte('/'))
+}
+
+func GetProjectNodeIndexKey(nodeDid ixo.Did, projectDid ixo.Did) []byte {
+	return append(GetProjectNodePrefixKey(nodeDid), []byte(projectDid)...)
+}
+
+// GetProjectCountKey returns the key of the number of projects stored under
+// the prefix, which is ProjectKey or the prefix of an index
+func GetProjectCountKey(prefix []byte) []byte {
+	return append(ProjectCountKey, prefix...)
+}
+
 func GetAccountPrefixKey(did ixo.Did) []byte {
 	return append(AccountKey, []byte(did)...)
 }
 
+func GetMigratedAccountKey(oldAddress sdk.AccAddress) []byte {
+	return append(MigratedAccountKey, oldAddress.Bytes()...)
+}
+
 func GetWithdrawalPrefixKey(did ixo.Did) []byte {
 	return append(WithdrawalKey, []byte(did)...)
 }
+
+func GetWithdrawalQueueKey(id uint64) []byte {
+	return append(WithdrawalQueueKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetWithdrawalStatusPrefixKey(status WithdrawalStatus) []byte {
+	return append(append(WithdrawalStatusIndexKey, []byte(status)...), byte('/'))
+}
+
+func GetWithdrawalStatusIndexKey(status WithdrawalStatus, id uint64) []byte {
+	return append(GetWithdrawalStatusPrefixKey(status), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetEthDepositKey(projectDid ixo.Did, ethTxHash string) []byte {
+	key := append(append(EthDepositKey, []byte(projectDid)...), byte('/'))
+	return append(key, []byte(strings.ToLower(ethTxHash))...)
+}
+
+func GetAppliedEthTxKey(ethTxHash string) []byte {
+	return append(AppliedEthTxKey, []byte(strings.ToLower(ethTxHash))...)
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+// ProjectDocV0 is the project document as it was stored before the required
+// claims, evaluator pay and creation time were typed. The evaluator pay was a
+// whole number of IXO.
+type ProjectDocV0 struct {
+	NodeDid              string        `json:"nodeDid"`
+	RequiredClaims       string        `json:"requiredClaims"`
+	EvaluatorPayPerClaim string        `json:"evaluatorPayPerClaim"`
+	ServiceEndpoint      string        `json:"serviceEndpoint"`
+	CreatedOn            string        `json:"createdOn"`
+	CreatedBy            string        `json:"createdBy"`
+	Status               ProjectStatus `json:"status"`
+}
+
+// CreateProjectMsgV0 is the stored project message of ProjectDocV0. It was
+// stored with the amino prefix of "ixo-cosmos/MsgCreateProject", so it has to
+// be decoded with LegacyProjectDocCodec.
+type CreateProjectMsgV0 struct {
+	SignBytes  string       `json:"signBytes"`
+	TxHash     string       `json:"txHash"`
+	SenderDid  ixo.Did      `json:"senderDid"`
+	ProjectDid ixo.Did      `json:"projectDid"`
+	PubKey     string       `json:"pubKey"`
+	Data       ProjectDocV0 `json:"data"`
+}
+
+// LegacyProjectDocCodec returns a codec that decodes the project documents
+// stored as CreateProjectMsgV0, which is registered under the name that the
+// project message had when they were stored
+func LegacyProjectDocCodec() *codec.Codec {
+	cdc := codec.New()
+	cdc.RegisterConcrete(CreateProjectMsgV0{}, "ixo-cosmos/MsgCreateProject", nil)
+	return cdc
+}
+
+// Migrate converts the stored message to the typed project document. Fields
+// that cannot be parsed are left empty and reported in the returned problems,
+// so that a single malformed project does not stop the migration.
+func (msg CreateProjectMsgV0) Migrate() (migrated CreateProjectMsg, problems []string) {
+	doc := ProjectDoc{
+		NodeDid:              msg.Data.NodeDid,
+		EvaluatorPayPerClaim: sdk.NewCoin(ixo.IxoNativeToken, sdk.ZeroInt()),
+		ServiceEndpoint:      msg.Data.ServiceEndpoint,
+		CreatedBy:            msg.Data.CreatedBy,
+		Status:               msg.Data.Status,
+	}
+
+	requiredClaims, err := strconv.ParseInt(msg.Data.RequiredClaims, 10, 64)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("invalid requiredClaims %q", msg.Data.RequiredClaims))
+	} else {
+		doc.RequiredClaims = requiredClaims
+	}
+
+	if msg.Data.EvaluatorPayPerClaim != "" {
+		pay, err := strconv.ParseInt(msg.Data.EvaluatorPayPerClaim, 10, 64)
+		if err != nil || pay < 0 {
+			problems = append(problems, fmt.Sprintf("invalid evaluatorPayPerClaim %q", msg.Data.EvaluatorPayPerClaim))
+		} else {
+			amount := sdk.NewDec(pay).Mul(ixo.IxoDecimals).TruncateInt()
+			doc.EvaluatorPayPerClaim = sdk.NewCoin(ixo.IxoNativeToken, amount)
+		}
+	}
+
+	createdOn, err := time.Parse(time.RFC3339, msg.Data.CreatedOn)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("invalid createdOn %q", msg.Data.CreatedOn))
+	} else {
+		doc.CreatedOn = createdOn.UTC()
+	}
+
+	return CreateProjectMsg{
+		SignBytes:  msg.SignBytes,
+		TxHash:     msg.TxHash,
+		SenderDid:  msg.SenderDid,
+		ProjectDid: msg.ProjectDid,
+		PubKey:     msg.PubKey,
+		Data:       doc,
+	}, problems
+}
@@ -33,22 +33,7 @@ func (msg CreateProjectMsg) ValidateBasic() sdk.Error {
 		return err
 	}
 
-	valid, err = CheckNotEmpty(msg.Data.NodeDid, "NodeDid")
-	if !valid {
-		return err
-	}
-
-	valid, err = CheckNotEmpty(msg.Data.RequiredClaims, "RequiredClaims")
-	if !valid {
-		return err
-	}
-
-	valid, err = CheckNotEmpty(msg.Data.CreatedBy, "CreatedBy")
-	if !valid {
-		return err
-	}
-
-	return nil
+	return msg.Data.Validate()
 }
 
 func (msg CreateProjectMsg) GetProjectDid() ixo.Did { return msg.ProjectDid }
@@ -65,9 +50,11 @@ func (msg CreateProjectMsg) String() string {
 	return string(b)
 }
 
-func (msg CreateProjectMsg) GetPubKey() string        { return msg.PubKey }
-func (msg CreateProjectMsg) GetEvaluatorPay() int64   { return msg.Data.GetEvaluatorPay() }
-func (msg CreateProjectMsg) GetStatus() ProjectStatus { return msg.Data.Status }
+func (msg CreateProjectMsg) GetPubKey() string         { return msg.PubKey }
+func (msg CreateProjectMsg) GetEvaluatorPay() sdk.Coin { return msg.Data.GetEvaluatorPay() }
+func (msg CreateProjectMsg) GetStatus() ProjectStatus  { return msg.Data.Status }
+func (msg CreateProjectMsg) GetCreatedBy() ixo.Did     { return msg.Data.CreatedBy }
+func (msg CreateProjectMsg) GetNodeDid() ixo.Did       { return msg.Data.NodeDid }
 func (msg *CreateProjectMsg) SetStatus(status ProjectStatus) {
 	msg.Data.Status = status
 }
@@ -115,6 +102,51 @@ func (msg UpdateProjectStatusMsg) GetEthFundingTxnID() string {
 	return msg.Data.EthFundingTxnID
 }
 
+// UpdateProjectDocMsg changes the mutable fields of a project document. It is
+// signed by the project DID, and is only accepted before the project is funded.
+type UpdateProjectDocMsg struct {
+	SignBytes  string           `json:"signBytes"`
+	TxHash     string           `json:"txHash"`
+	SenderDid  ixo.Did          `json:"senderDid"`
+	ProjectDid ixo.Did          `json:"projectDid"`
+	Data       UpdateProjectDoc `json:"data"`
+}
+
+func (msg UpdateProjectDocMsg) IsNewDid() bool                          { return false }
+func (msg UpdateProjectDocMsg) IsWithdrawal() bool                      { return false }
+func (msg UpdateProjectDocMsg) Type() string                            { return ModuleName }
+func (msg UpdateProjectDocMsg) Route() string                           { return RouterKey }
+func (msg UpdateProjectDocMsg) Get(key interface{}) (value interface{}) { return nil }
+func (msg UpdateProjectDocMsg) ValidateBasic() sdk.Error {
+	valid, err := CheckNotEmpty(msg.ProjectDid, "ProjectDid")
+	if !valid {
+		return err
+	}
+
+	return msg.Data.Validate()
+}
+
+func (msg UpdateProjectDocMsg) GetProjectDid() ixo.Did { return msg.ProjectDid }
+func (msg UpdateProjectDocMsg) GetSenderDid() ixo.Did  { return msg.SenderDid }
+func (msg UpdateProjectDocMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.GetProjectDid())}
+}
+
+func (msg UpdateProjectDocMsg) GetSignBytes() []byte {
+	return []byte(msg.SignBytes)
+}
+
+func (msg UpdateProjectDocMsg) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
+var _ sdk.Msg = UpdateProjectDocMsg{}
+
 type CreateAgentMsg struct {
 	SignBytes  string         `json:"signBytes"`
 	TxHash     string         `json:"txHash"`
@@ -301,3 +333,155 @@ func (msg WithdrawFundsMsg) String() string {
 }
 
 var _ sdk.Msg = WithdrawFundsMsg{}
+
+// UpdateWithdrawalStatusMsg is sent by the withdrawal relayer to report the
+// progress of a queued withdrawal, signed by the relayer's DID
+type UpdateWithdrawalStatusMsg struct {
+	SignBytes string                    `json:"signBytes"`
+	SenderDid ixo.Did                   `json:"senderDid"`
+	Data      UpdateWithdrawalStatusDoc `json:"data"`
+}
+
+func (msg UpdateWithdrawalStatusMsg) IsNewDid() bool                          { return false }
+func (msg UpdateWithdrawalStatusMsg) IsWithdrawal() bool                      { return true }
+func (msg UpdateWithdrawalStatusMsg) Type() string                            { return ModuleName }
+func (msg UpdateWithdrawalStatusMsg) Route() string                           { return RouterKey }
+func (msg UpdateWithdrawalStatusMsg) Get(key interface{}) (value interface{}) { return nil }
+func (msg UpdateWithdrawalStatusMsg) ValidateBasic() sdk.Error {
+	valid, err := CheckNotEmpty(msg.SenderDid, "SenderDid")
+	if !valid {
+		return err
+	}
+
+	switch msg.Data.Status {
+	case WithdrawalSubmitted:
+		valid, err = CheckNotEmpty(msg.Data.EthTxHash, "EthTxHash")
+		if !valid {
+			return err
+		}
+
+		valid, err = CheckNotEmpty(msg.Data.ProjectEthWallet, "ProjectEthWallet")
+		if !valid {
+			return err
+		}
+	case WithdrawalConfirmed:
+	case WithdrawalFailed:
+		valid, err = CheckNotEmpty(msg.Data.FailureReason, "FailureReason")
+		if !valid {
+			return err
+		}
+	default:
+		return sdk.ErrUnknownRequest("Invalid withdrawal status " + string(msg.Data.Status))
+	}
+
+	return nil
+}
+
+func (msg UpdateWithdrawalStatusMsg) GetSenderDid() ixo.Did { return msg.SenderDid }
+func (msg UpdateWithdrawalStatusMsg) GetUpdateWithdrawalStatusDoc() UpdateWithdrawalStatusDoc {
+	return msg.Data
+}
+func (msg UpdateWithdrawalStatusMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{[]byte(msg.GetSenderDid())}
+}
+
+func (msg UpdateWithdrawalStatusMsg) GetSignBytes() []byte {
+	return []byte(msg.SignBytes)
+}
+
+func (msg UpdateWithdrawalStatusMsg) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
+var _ sdk.Msg = UpdateWithdrawalStatusMsg{}
+
+const TypeMsgAttestEthDeposit = "attest_eth_deposit"
+
+// MsgAttestEthDeposit is sent by a validator to attest to the amount and
+// project wallet of a project funding transfer on Ethereum. Unlike the other
+// project messages, it is a standard cosmos message signed by the validator
+// operator's account.
+type MsgAttestEthDeposit struct {
+	Validator        sdk.ValAddress `json:"validator"`
+	ProjectDid       ixo.Did        `json:"projectDid"`
+	EthTxHash        string         `json:"ethTxHash"`
+	ProjectEthWallet string         `json:"projectEthWallet"`
+	Amount           sdk.Int        `json:"amount"`
+}
+
+func NewMsgAttestEthDeposit(validator sdk.ValAddress, projectDid ixo.Did, ethTxHash string,
+	projectEthWallet string, amount sdk.Int) MsgAttestEthDeposit {
+	return MsgAttestEthDeposit{
+		Validator:        validator,
+		ProjectDid:       projectDid,
+		EthTxHash:        ethTxHash,
+		ProjectEthWallet: projectEthWallet,
+		Amount:           amount,
+	}
+}
+
+func (msg MsgAttestEthDeposit) Type() string  { return TypeMsgAttestEthDeposit }
+func (msg MsgAttestEthDeposit) Route() string { return RouterKey }
+func (msg MsgAttestEthDeposit) ValidateBasic() sdk.Error {
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress("Validator is empty.")
+	}
+
+	valid, err := CheckNotEmpty(msg.ProjectDid, "ProjectDid")
+	if !valid {
+		return err
+	}
+
+	valid, err = CheckNotEmpty(msg.EthTxHash, "EthTxHash")
+	if !valid {
+		return err
+	}
+
+	valid, err = CheckNotEmpty(msg.ProjectEthWallet, "ProjectEthWallet")
+	if !valid {
+		return err
+	}
+
+	if msg.Amount == (sdk.Int{}) || !msg.Amount.IsPositive() {
+		return sdk.ErrUnknownRequest("Amount must be positive.")
+	}
+
+	return nil
+}
+
+func (msg MsgAttestEthDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Validator)}
+}
+
+func (msg MsgAttestEthDeposit) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgAttestEthDeposit) String() string {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
+func (msg MsgAttestEthDeposit) Attestation() EthDepositAttestation {
+	return EthDepositAttestation{
+		Validator:        msg.Validator,
+		ProjectEthWallet: msg.ProjectEthWallet,
+		Amount:           msg.Amount,
+	}
+}
+
+var _ sdk.Msg = MsgAttestEthDeposit{}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateProjectMsgValidateBasic(t *testing.T) {
+	require.Nil(t, ValidCreateProjectMsg.ValidateBasic())
+
+	// Evaluator pay is optional
+	msg := ValidCreateProjectMsg
+	msg.Data.EvaluatorPayPerClaim = sdk.Coin{}
+	require.Nil(t, msg.ValidateBasic())
+	require.True(t, msg.GetEvaluatorPay().IsZero())
+
+	msg = ValidCreateProjectMsg
+	msg.Data.RequiredClaims = 0
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = ValidCreateProjectMsg
+	msg.Data.EvaluatorPayPerClaim = sdk.NewInt64Coin("stake", 10)
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg = ValidCreateProjectMsg
+	msg.Data.CreatedOn = time.Time{}
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestUpdateProjectDocMsgValidateBasic(t *testing.T) {
+	msg := UpdateProjectDocMsg{
+		ProjectDid: ValidCreateProjectMsg.ProjectDid,
+		Data: UpdateProjectDoc{
+			RequiredClaims:  10,
+			ServiceEndpoint: "https://pds.ixo.world",
+		},
+	}
+	require.Nil(t, msg.ValidateBasic())
+
+	invalid := msg
+	invalid.Data.ServiceEndpoint = ""
+	require.NotNil(t, invalid.ValidateBasic())
+
+	invalid = msg
+	invalid.Data.ServiceEndpoint = "pds.ixo.world"
+	require.NotNil(t, invalid.ValidateBasic())
+
+	invalid = msg
+	invalid.Data.RequiredClaims = 0
+	require.NotNil(t, invalid.ValidateBasic())
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+const (
+	DefaultProjectsPerPage uint64 = 30
+	MaxProjectsPerPage     uint64 = 100
+)
+
+// QueryProjectsParams selects projects by status, creator DID and node DID.
+// Empty criteria match every project.
+type QueryProjectsParams struct {
+	Status     ProjectStatus `json:"status" yaml:"status"`
+	CreatorDid ixo.Did       `json:"creatorDid" yaml:"creatorDid"`
+	NodeDid    ixo.Did       `json:"nodeDid" yaml:"nodeDid"`
+	Page       uint64        `json:"page" yaml:"page"`
+	Limit      uint64        `json:"limit" yaml:"limit"`
+}
+
+func NewQueryProjectsParams(status ProjectStatus, creatorDid, nodeDid ixo.Did,
+	page, limit uint64) QueryProjectsParams {
+	return QueryProjectsParams{
+		Status:     status,
+		CreatorDid: creatorDid,
+		NodeDid:    nodeDid,
+		Page:       page,
+		Limit:      limit,
+	}
+}
+
+func (p QueryProjectsParams) ValidatePagination() error {
+	if p.Page == 0 {
+		return fmt.Errorf("page must be positive")
+	} else if p.Limit == 0 {
+		return fmt.Errorf("limit must be positive")
+	} else if p.Limit > MaxProjectsPerPage {
+		return fmt.Errorf("limit must not exceed %d", MaxProjectsPerPage)
+	} else if !ixo.ValidPage(p.Page, p.Limit) {
+		return fmt.Errorf("page must not exceed %d", ixo.MaxPage(p.Limit))
+	}
+	return nil
+}
+
+// Matches returns true if the project doc satisfies every given criterion
+func (p QueryProjectsParams) Matches(projectDoc StoredProjectDoc) bool {
+	if p.Status != NullStatus && projectDoc.GetStatus() != p.Status {
+		return false
+	} else if p.CreatorDid != "" && projectDoc.GetCreatedBy() != p.CreatorDid {
+		return false
+	} else if p.NodeDid != "" && projectDoc.GetNodeDid() != p.NodeDid {
+		return false
+	}
+	return true
+}
+
+type QueryProjectsResult struct {
+	Total    uint64             `json:"total" yaml:"total"`
+	Page     uint64             `json:"page" yaml:"page"`
+	Limit    uint64             `json:"limit" yaml:"limit"`
+	Projects []CreateProjectMsg `json:"projects" yaml:"projects"`
+}
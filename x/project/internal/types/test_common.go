@@ -1,7 +1,12 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
 )
 
 var ValidCreateProjectMsg = CreateProjectMsg{
@@ -12,10 +17,10 @@ var ValidCreateProjectMsg = CreateProjectMsg{
 	PubKey:     "PubKey",
 	Data: ProjectDoc{
 		NodeDid:              "nodeDid",
-		RequiredClaims:       "3",
-		EvaluatorPayPerClaim: "2",
+		RequiredClaims:       3,
+		EvaluatorPayPerClaim: sdk.NewInt64Coin(ixo.IxoNativeToken, 200000000),
 		ServiceEndpoint:      "https://google.co.in",
-		CreatedOn:            "time1",
+		CreatedOn:            time.Date(2018, 5, 21, 15, 53, 18, 484000000, time.UTC),
 		CreatedBy:            "time2",
 		Status:               "CREATED",
 	},
@@ -29,10 +34,10 @@ var ValidUpdateProjectMsg = CreateProjectMsg{
 	PubKey:     "PubKey",
 	Data: ProjectDoc{
 		NodeDid:              "nodeDid",
-		RequiredClaims:       "3",
-		EvaluatorPayPerClaim: "2",
+		RequiredClaims:       3,
+		EvaluatorPayPerClaim: sdk.NewInt64Coin(ixo.IxoNativeToken, 200000000),
 		ServiceEndpoint:      "https://google.co.in",
-		CreatedOn:            "time1",
+		CreatedOn:            time.Date(2018, 5, 21, 15, 53, 18, 484000000, time.UTC),
 		CreatedBy:            "time2",
 		Status:               "PENDING",
 	},
@@ -48,3 +53,26 @@ var ValidWithdrawalInfo = WithdrawalInfo{
 var (
 	ValidAddress1, _ = sdk.AccAddressFromHex("0F6A8D732716BA24B213D7C28984FBE1248D009D")
 )
+
+// FakeEthDeposit is a project funding transfer known to a FakeEthDepositSource
+type FakeEthDeposit struct {
+	ProjectDid       ixo.Did
+	ProjectEthWallet string
+	Amount           sdk.Int
+}
+
+// FakeEthDepositSource is a local stand-in for Ethereum, keyed by tx hash
+type FakeEthDepositSource map[string]FakeEthDeposit
+
+var _ EthDepositSource = FakeEthDepositSource{}
+
+func (s FakeEthDepositSource) GetProjectFunding(ethTxHash string, projectDid ixo.Did) (string, sdk.Int, error) {
+	deposit, ok := s[ethTxHash]
+	if !ok {
+		return "", sdk.Int{}, fmt.Errorf("transaction %s not found", ethTxHash)
+	} else if deposit.ProjectDid != projectDid {
+		return "", sdk.Int{}, fmt.Errorf("transaction %s does not fund project %s", ethTxHash, projectDid)
+	}
+
+	return deposit.ProjectEthWallet, deposit.Amount, nil
+}
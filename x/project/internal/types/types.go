@@ -1,7 +1,9 @@
 package types
 
 import (
-	"strconv"
+	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,11 +18,30 @@ type Config struct {
 
 type AccountMap map[string]interface{}
 
+type InternalAccountID = string
+
+// MigratedAccount records that a project account which used to be at
+// OldAddress, the hex encoding of "projectDid/accountId", has been moved to
+// the address derived by ixo.DidAccountToAddr
+type MigratedAccount struct {
+	OldAddress string         `json:"oldAddress"`
+	NewAddress sdk.AccAddress `json:"newAddress"`
+}
+
+const (
+	IxoAccountFeesId               InternalAccountID = "IxoFees"
+	IxoAccountPayFeesId            InternalAccountID = "IxoPayFees"
+	InitiatingNodeAccountPayFeesId InternalAccountID = "InitiatingNodePayFees"
+	ValidatingNodeSetAccountFeesId InternalAccountID = "ValidatingNodeSetFees"
+)
+
 type StoredProjectDoc interface {
-	GetEvaluatorPay() int64
+	GetEvaluatorPay() sdk.Coin
 	GetProjectDid() ixo.Did
 	GetPubKey() string
 	GetStatus() ProjectStatus
+	GetCreatedBy() ixo.Did
+	GetNodeDid() ixo.Did
 	SetStatus(status ProjectStatus)
 }
 
@@ -50,6 +71,11 @@ func initStateTransitions() map[ProjectStatus][]ProjectStatus {
 
 }
 
+// AllowsDocUpdates returns true if the project document can still be changed
+func (status ProjectStatus) AllowsDocUpdates() bool {
+	return status == CreatedProject || status == PendingStatus
+}
+
 func (nextProjectStatus ProjectStatus) IsValidProgressionFrom(previousProjectStatus ProjectStatus) bool {
 	validStatuses := StateTransitions[previousProjectStatus]
 	for _, v := range validStatuses {
@@ -79,25 +105,113 @@ func (ps UpdateProjectStatusDoc) GetEthFundingTxnID() string {
 
 type ProjectDoc struct {
 	NodeDid              string        `json:"nodeDid"`
-	RequiredClaims       string        `json:"requiredClaims"`
-	EvaluatorPayPerClaim string        `json:"evaluatorPayPerClaim"`
+	RequiredClaims       int64         `json:"requiredClaims,string"`
+	EvaluatorPayPerClaim sdk.Coin      `json:"evaluatorPayPerClaim"`
 	ServiceEndpoint      string        `json:"serviceEndpoint"`
-	CreatedOn            string        `json:"createdOn"`
+	CreatedOn            time.Time     `json:"createdOn"`
 	CreatedBy            string        `json:"createdBy"`
 	Status               ProjectStatus `json:"status"`
 }
 
-func (pd ProjectDoc) GetEvaluatorPay() int64 {
-	if pd.EvaluatorPayPerClaim == "" {
-		return 0
-	} else {
-		i, err := strconv.ParseInt(pd.EvaluatorPayPerClaim, 10, 64)
-		if err != nil {
-			panic(err)
-		}
+// GetEvaluatorPay returns the pay per evaluated claim, in ixo native tokens.
+// A project without evaluator pay returns a zero coin.
+func (pd ProjectDoc) GetEvaluatorPay() sdk.Coin {
+	if pd.EvaluatorPayPerClaim.Amount == (sdk.Int{}) {
+		return sdk.NewCoin(ixo.IxoNativeToken, sdk.ZeroInt())
+	}
+
+	return pd.EvaluatorPayPerClaim
+}
 
-		return i
+// Validate checks the typed project fields. Evaluator pay is optional, but
+// has to be paid in ixo native tokens when set.
+func (pd ProjectDoc) Validate() sdk.Error {
+	valid, err := CheckNotEmpty(pd.NodeDid, "NodeDid")
+	if !valid {
+		return err
 	}
+
+	valid, err = CheckNotEmpty(pd.CreatedBy, "CreatedBy")
+	if !valid {
+		return err
+	}
+
+	if err := validateRequiredClaims(pd.RequiredClaims); err != nil {
+		return err
+	} else if err := validateEvaluatorPay(pd.EvaluatorPayPerClaim); err != nil {
+		return err
+	}
+
+	if pd.CreatedOn.IsZero() {
+		return ErrInvalidProjectDoc("CreatedOn is empty")
+	}
+
+	return nil
+}
+
+// Update returns the project document with the mutable fields replaced
+func (pd ProjectDoc) Update(update UpdateProjectDoc) ProjectDoc {
+	pd.RequiredClaims = update.RequiredClaims
+	pd.EvaluatorPayPerClaim = update.EvaluatorPayPerClaim
+	pd.ServiceEndpoint = update.ServiceEndpoint
+	return pd
+}
+
+// UpdateProjectDoc holds the project fields that can still be changed after
+// the project is created, as long as it has not been funded
+type UpdateProjectDoc struct {
+	RequiredClaims       int64    `json:"requiredClaims,string"`
+	EvaluatorPayPerClaim sdk.Coin `json:"evaluatorPayPerClaim"`
+	ServiceEndpoint      string   `json:"serviceEndpoint"`
+}
+
+func (ud UpdateProjectDoc) Validate() sdk.Error {
+	if err := validateRequiredClaims(ud.RequiredClaims); err != nil {
+		return err
+	} else if err := validateEvaluatorPay(ud.EvaluatorPayPerClaim); err != nil {
+		return err
+	}
+
+	return validateServiceEndpoint(ud.ServiceEndpoint)
+}
+
+func validateRequiredClaims(requiredClaims int64) sdk.Error {
+	if requiredClaims <= 0 {
+		return ErrInvalidProjectDoc(fmt.Sprintf("RequiredClaims must be positive: %d", requiredClaims))
+	}
+
+	return nil
+}
+
+func validateServiceEndpoint(endpoint string) sdk.Error {
+	if len(endpoint) == 0 {
+		return ErrInvalidProjectDoc("ServiceEndpoint is empty")
+	} else if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return ErrInvalidProjectDoc(fmt.Sprintf("ServiceEndpoint is not a valid URL: %s", endpoint))
+	}
+
+	return nil
+}
+
+func validateEvaluatorPay(pay sdk.Coin) sdk.Error {
+	if pay.Amount == (sdk.Int{}) {
+		return nil
+	} else if pay.Denom != ixo.IxoNativeToken {
+		return ErrInvalidProjectDoc("EvaluatorPayPerClaim must be in " + ixo.IxoNativeToken)
+	} else if pay.IsNegative() {
+		return ErrInvalidProjectDoc("EvaluatorPayPerClaim cannot be negative")
+	}
+
+	return nil
+}
+
+// ProjectDocVersion is a previous version of a project document, kept when
+// the document is updated. Height is the block height at which the version
+// was replaced, so each version was in effect until that height.
+type ProjectDocVersion struct {
+	Version    uint64     `json:"version"`
+	Height     int64      `json:"height"`
+	ProjectDoc ProjectDoc `json:"projectDoc"`
 }
 
 type ProjectDocDecoder func(projectEntryBytes []byte) (StoredProjectDoc, error)
@@ -18,50 +18,60 @@ func NewCreateProjectMsg(projectDoc ProjectDoc, projectDid sovrin.SovrinDid) Cre
 	}
 }
 
-func NewUpdateProjectStatusMsg(txHash string, senderDid string, updateProjectStatusDoc UpdateProjectStatusDoc, projectDid sovrin.SovrinDid) UpdateProjectStatusMsg {
+func NewUpdateProjectStatusMsg(txHash string, senderDid string, updateProjectStatusDoc UpdateProjectStatusDoc, projectDid ixo.Did) UpdateProjectStatusMsg {
 	return UpdateProjectStatusMsg{
 		SignBytes:  "",
 		TxHash:     txHash,
 		SenderDid:  senderDid,
-		ProjectDid: projectDid.Did,
+		ProjectDid: projectDid,
 		Data:       updateProjectStatusDoc,
 	}
 }
 
-func NewCreateAgentMsg(txHash string, senderDid string, createAgentDoc CreateAgentDoc, projectDid sovrin.SovrinDid) CreateAgentMsg {
+func NewUpdateProjectDocMsg(txHash string, senderDid string, updateProjectDoc UpdateProjectDoc, projectDid ixo.Did) UpdateProjectDocMsg {
+	return UpdateProjectDocMsg{
+		SignBytes:  "",
+		TxHash:     txHash,
+		SenderDid:  senderDid,
+		ProjectDid: projectDid,
+		Data:       updateProjectDoc,
+	}
+}
+
+func NewCreateAgentMsg(txHash string, senderDid string, createAgentDoc CreateAgentDoc, projectDid ixo.Did) CreateAgentMsg {
 	return CreateAgentMsg{
 		SignBytes:  "",
-		ProjectDid: projectDid.Did,
+		ProjectDid: projectDid,
 		TxHash:     txHash,
 		SenderDid:  senderDid,
 		Data:       createAgentDoc,
 	}
 }
 
-func NewUpdateAgentMsg(txHash string, senderDid string, updateAgentDoc UpdateAgentDoc, projectDid sovrin.SovrinDid) UpdateAgentMsg {
+func NewUpdateAgentMsg(txHash string, senderDid string, updateAgentDoc UpdateAgentDoc, projectDid ixo.Did) UpdateAgentMsg {
 	return UpdateAgentMsg{
 		SignBytes:  "",
-		ProjectDid: projectDid.Did,
+		ProjectDid: projectDid,
 		TxHash:     txHash,
 		SenderDid:  senderDid,
 		Data:       updateAgentDoc,
 	}
 }
 
-func NewCreateClaimMsg(txHash string, senderDid string, createClaimDoc CreateClaimDoc, projectDid sovrin.SovrinDid) CreateClaimMsg {
+func NewCreateClaimMsg(txHash string, senderDid string, createClaimDoc CreateClaimDoc, projectDid ixo.Did) CreateClaimMsg {
 	return CreateClaimMsg{
 		SignBytes:  "",
-		ProjectDid: projectDid.Did,
+		ProjectDid: projectDid,
 		TxHash:     txHash,
 		SenderDid:  senderDid,
 		Data:       createClaimDoc,
 	}
 }
 
-func NewCreateEvaluationMsg(txHash string, senderDid string, createEvaluationDoc CreateEvaluationDoc, projectDid sovrin.SovrinDid) CreateEvaluationMsg {
+func NewCreateEvaluationMsg(txHash string, senderDid string, createEvaluationDoc CreateEvaluationDoc, projectDid ixo.Did) CreateEvaluationMsg {
 	return CreateEvaluationMsg{
 		SignBytes:  "",
-		ProjectDid: projectDid.Did,
+		ProjectDid: projectDid,
 		TxHash:     txHash,
 		SenderDid:  senderDid,
 		Data:       createEvaluationDoc,
@@ -83,3 +93,11 @@ func NewWithDrawFundsMsg(senderDid ixo.Did, data WithdrawFundsDoc) WithdrawFunds
 		Data:      data,
 	}
 }
+
+func NewUpdateWithdrawalStatusMsg(senderDid ixo.Did, data UpdateWithdrawalStatusDoc) UpdateWithdrawalStatusMsg {
+	return UpdateWithdrawalStatusMsg{
+		SignBytes: "",
+		SenderDid: senderDid,
+		Data:      data,
+	}
+}
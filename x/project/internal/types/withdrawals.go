@@ -0,0 +1,86 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ixofoundation/ixo-cosmos/x/ixo"
+)
+
+type WithdrawalStatus string
+
+const (
+	WithdrawalPending   WithdrawalStatus = "pending"
+	WithdrawalSubmitted WithdrawalStatus = "submitted"
+	WithdrawalConfirmed WithdrawalStatus = "confirmed"
+	WithdrawalFailed    WithdrawalStatus = "failed"
+)
+
+var WithdrawalStateTransitions = map[WithdrawalStatus][]WithdrawalStatus{
+	WithdrawalPending:   {WithdrawalSubmitted, WithdrawalFailed},
+	WithdrawalSubmitted: {WithdrawalConfirmed, WithdrawalFailed},
+	WithdrawalConfirmed: {},
+	WithdrawalFailed:    {},
+}
+
+func (nextStatus WithdrawalStatus) IsValid() bool {
+	_, ok := WithdrawalStateTransitions[nextStatus]
+	return ok
+}
+
+func (nextStatus WithdrawalStatus) IsValidProgressionFrom(previousStatus WithdrawalStatus) bool {
+	validStatuses := WithdrawalStateTransitions[previousStatus]
+	for _, v := range validStatuses {
+		if v == nextStatus {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Withdrawal is an entry in the outbound queue of ERC20 transfers. The tokens
+// are burnt from the project account when the withdrawal is queued, and the
+// transfer itself is submitted to Ethereum by the withdrawal relayer, which
+// reports its progress back with an UpdateWithdrawalStatusMsg.
+type Withdrawal struct {
+	ID                  uint64           `json:"id"`
+	ProjectDid          ixo.Did          `json:"projectDid"`
+	AccountID           string           `json:"accountID"`
+	RecipientEthAddress string           `json:"recipientEthAddress"`
+	Amount              sdk.Coin         `json:"amount"`
+	Status              WithdrawalStatus `json:"status"`
+	Height              int64            `json:"height"`
+	ProjectEthWallet    string           `json:"projectEthWallet"`
+	EthTxHash           string           `json:"ethTxHash"`
+	FailureReason       string           `json:"failureReason"`
+}
+
+func NewWithdrawal(id uint64, projectDid ixo.Did, accountID, recipientEthAddress string,
+	amount sdk.Coin, height int64) Withdrawal {
+	return Withdrawal{
+		ID:                  id,
+		ProjectDid:          projectDid,
+		AccountID:           accountID,
+		RecipientEthAddress: recipientEthAddress,
+		Amount:              amount,
+		Status:              WithdrawalPending,
+		Height:              height,
+	}
+}
+
+// ActionID is the ID under which the transfer is validated by the Ethereum
+// auth contract. It is derived from the withdrawal ID so that a transfer that
+// is submitted more than once is only ever performed once.
+func (w Withdrawal) ActionID() [32]byte {
+	var actionID [32]byte
+	copy(actionID[24:], sdk.Uint64ToBigEndian(w.ID))
+	return actionID
+}
+
+type UpdateWithdrawalStatusDoc struct {
+	WithdrawalID     uint64           `json:"withdrawalID"`
+	Status           WithdrawalStatus `json:"status"`
+	ProjectEthWallet string           `json:"projectEthWallet"`
+	EthTxHash        string           `json:"ethTxHash"`
+	FailureReason    string           `json:"failureReason"`
+}
@@ -14,9 +14,9 @@ import (
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/ixofoundation/ixo-cosmos/x/contracts"
+	didcli "github.com/ixofoundation/ixo-cosmos/x/did/client/cli"
 	"github.com/ixofoundation/ixo-cosmos/x/fees"
-	"github.com/ixofoundation/ixo-cosmos/x/ixo"
-	"github.com/ixofoundation/ixo-cosmos/x/params"
+	"github.com/ixofoundation/ixo-cosmos/x/node"
 	"github.com/ixofoundation/ixo-cosmos/x/project/client/cli"
 	"github.com/ixofoundation/ixo-cosmos/x/project/client/rest"
 	"github.com/ixofoundation/ixo-cosmos/x/project/internal/keeper"
@@ -38,11 +38,16 @@ func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 }
 
 func (AppModuleBasic) DefaultGenesis() json.RawMessage {
-	return nil
+	return ModuleCdc.MustMarshalJSON(DefaultGenesisState())
 }
 
 func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
-	return nil
+	var data GenesisState
+	err := ModuleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
 }
 
 func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
@@ -58,14 +63,27 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	projectTxCmd.AddCommand(client.PostCommands(
+	projectTxCmd.AddCommand(client.PostCommands(didcli.FromDidCommands(
 		cli.CreateProjectCmd(cdc),
+		cli.WithDrawFundsCmd(cdc),
+		cli.UpdateWithdrawalStatusCmd(cdc),
+		cli.RelayWithdrawalsCmd(cdc),
+	)...)...)
+
+	// Commands on an existing project can be signed by its controllers and
+	// delegates with --project-did
+	projectTxCmd.AddCommand(client.PostCommands(didcli.FromDidCommands(cli.ProjectDidCommands(
 		cli.CreateAgentCmd(cdc),
 		cli.UpdateProjectStatusCmd(cdc),
+		cli.UpdateProjectDocCmd(cdc),
 		cli.UpdateAgentCmd(cdc),
 		cli.CreateClaimCmd(cdc),
 		cli.CreateEvaluationCmd(cdc),
-		cli.WithDrawFundsCmd(cdc),
+	)...)...)...)
+
+	// Attestations are signed by the validator operator with --from
+	projectTxCmd.AddCommand(client.PostCommands(
+		cli.AttestEthDepositsCmd(cdc),
 	)...)
 
 	return projectTxCmd
@@ -82,8 +100,16 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	projectQueryCmd.AddCommand(client.GetCommands(
 		cli.GetProjectDocCmd(cdc),
+		cli.GetProjectDocHistoryCmd(cdc),
+		cli.GetProjectDocsCmd(cdc),
 		cli.GetProjectAccountsCmd(cdc),
+		cli.GetMigratedAccountCmd(cdc),
 		cli.GetProjectTxsCmd(cdc),
+		cli.GetWithdrawalCmd(cdc),
+		cli.GetWithdrawalsCmd(cdc),
+		cli.GetEthDepositCmd(cdc),
+		cli.GetPendingEthDepositsCmd(cdc),
+		cli.GetFeeQuoteCmd(cdc),
 	)...)
 
 	return projectQueryCmd
@@ -94,22 +120,20 @@ type AppModule struct {
 	keeper         keeper.Keeper
 	feesKeeper     fees.Keeper
 	contractKeeper contracts.Keeper
+	nodeKeeper     node.Keeper
 	bankKeeper     bank.Keeper
-	paramsKeeper   params.Keeper
-	ethClient      ixo.EthClient
 }
 
 func NewAppModule(keeper Keeper, feesKeeper fees.Keeper, contractKeeper contracts.Keeper,
-	bankKeeper bank.Keeper, paramsKeeper params.Keeper, ethClient ixo.EthClient) AppModule {
+	nodeKeeper node.Keeper, bankKeeper bank.Keeper) AppModule {
 
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
 		feesKeeper:     feesKeeper,
 		contractKeeper: contractKeeper,
+		nodeKeeper:     nodeKeeper,
 		bankKeeper:     bankKeeper,
-		paramsKeeper:   paramsKeeper,
-		ethClient:      ethClient,
 	}
 }
 
@@ -124,7 +148,7 @@ func (AppModule) Route() string {
 }
 
 func (am AppModule) NewHandler() sdk.Handler {
-	return NewHandler(am.keeper, am.feesKeeper, am.contractKeeper, am.bankKeeper, am.paramsKeeper, am.ethClient)
+	return NewHandler(am.keeper, am.feesKeeper, am.contractKeeper, am.nodeKeeper, am.bankKeeper)
 }
 
 func (AppModule) QuerierRoute() string {
@@ -136,14 +160,19 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abciTypes.ValidatorUpdate {
+	var genesisState GenesisState
+	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
 	return []abciTypes.ValidatorUpdate{}
 }
 
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	return nil
+	gs := ExportGenesis(ctx, am.keeper)
+	return ModuleCdc.MustMarshalJSON(gs)
 }
 
 func (am AppModule) BeginBlock(ctx sdk.Context, req abciTypes.RequestBeginBlock) {
+	am.keeper.MigrateStore(ctx)
 }
 
 func (AppModule) EndBlock(_ sdk.Context, _ abciTypes.RequestEndBlock) []abciTypes.ValidatorUpdate {